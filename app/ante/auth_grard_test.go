@@ -98,6 +98,25 @@ func (s *AnteTestSuite) TestAnteDecoratorAuthzGuard() {
 			},
 			wantErr: "ExtensionOptionsEthereumTx",
 		},
+		{
+			name: "sad: authz exec with an evm message in a nested exec",
+			txMsg: func() sdk.Msg {
+				nestedExec := authz.NewMsgExec(
+					sdk.AccAddress("nibiuser"),
+					[]sdk.Msg{
+						&evm.MsgEthereumTx{},
+					},
+				)
+				msgExec := authz.NewMsgExec(
+					sdk.AccAddress("nibiuser"),
+					[]sdk.Msg{
+						&nestedExec,
+					},
+				)
+				return &msgExec
+			},
+			wantErr: "ExtensionOptionsEthereumTx",
+		},
 		{
 			name: "happy: authz exec without evm messages",
 			txMsg: func() sdk.Msg {
@@ -2,10 +2,7 @@
 package ante
 
 import (
-	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
@@ -18,50 +15,8 @@ type AnteDecoratorAuthzGuard struct{}
 func (rmd AnteDecoratorAuthzGuard) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
 ) (newCtx sdk.Context, err error) {
-	for _, msg := range tx.GetMsgs() {
-		// Do not allow grant for MsgEthereumTx
-		if msgGrant, ok := msg.(*authz.MsgGrant); ok {
-			if msgGrant.Grant.Authorization == nil {
-				return ctx, errors.Wrapf(
-					errortypes.ErrInvalidType,
-					"grant authorization is missing",
-				)
-			}
-			authorization, err := msgGrant.Grant.GetAuthorization()
-			if err != nil {
-				return ctx, errors.Wrapf(
-					errortypes.ErrInvalidType,
-					"failed unmarshaling generic authorization %s", err,
-				)
-			}
-			if genericAuth, ok := authorization.(*authz.GenericAuthorization); ok {
-				if genericAuth.MsgTypeURL() == sdk.MsgTypeURL(&evm.MsgEthereumTx{}) {
-					return ctx, errors.Wrapf(
-						errortypes.ErrNotSupported,
-						"authz grant generic for msg type %s is not allowed",
-						genericAuth.MsgTypeURL(),
-					)
-				}
-			}
-		}
-		// Also reject MsgEthereumTx in exec
-		if msgExec, ok := msg.(*authz.MsgExec); ok {
-			msgsInExec, err := msgExec.GetMessages()
-			if err != nil {
-				return ctx, errors.Wrapf(
-					errortypes.ErrInvalidType,
-					"failed getting exec messages %s", err,
-				)
-			}
-			for _, msgInExec := range msgsInExec {
-				if _, ok := msgInExec.(*evm.MsgEthereumTx); ok {
-					return ctx, errors.Wrapf(
-						errortypes.ErrInvalidType,
-						"MsgEthereumTx needs to be contained within a tx with 'ExtensionOptionsEthereumTx' option",
-					)
-				}
-			}
-		}
+	if err := evm.AssertAuthzMsgsAllowed(tx.GetMsgs()); err != nil {
+		return ctx, err
 	}
 	return next(ctx, tx, simulate)
 }
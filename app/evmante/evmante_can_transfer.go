@@ -38,7 +38,7 @@ func (ctd CanTransferDecorator) AnteHandle(
 			)
 		}
 
-		baseFeeWeiPerGas := evm.NativeToWei(ctd.EVMKeeper.BaseFeeMicronibiPerGas(ctx))
+		baseFeeWeiPerGas := ctd.EVMKeeper.BaseFeeWeiPerGas(ctx)
 
 		coreMsg, err := msgEthTx.AsMessage(signer, baseFeeWeiPerGas)
 		if err != nil {
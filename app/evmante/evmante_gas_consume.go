@@ -68,7 +68,7 @@ func (anteDec AnteDecEthGasConsume) AnteHandle(
 
 	// Use the lowest priority of all the messages as the final one.
 	minPriority := int64(math.MaxInt64)
-	baseFeeMicronibiPerGas := anteDec.evmKeeper.BaseFeeMicronibiPerGas(ctx)
+	baseFeeWeiPerGas := anteDec.evmKeeper.BaseFeeWeiPerGas(ctx)
 
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evm.MsgEthereumTx)
@@ -100,7 +100,7 @@ func (anteDec AnteDecEthGasConsume) AnteHandle(
 		fees, err := keeper.VerifyFee(
 			txData,
 			evm.EVMBankDenom,
-			baseFeeMicronibiPerGas,
+			baseFeeWeiPerGas,
 			ctx.IsCheckTx(),
 		)
 		if err != nil {
@@ -118,7 +118,7 @@ func (anteDec AnteDecEthGasConsume) AnteHandle(
 			),
 		)
 
-		priority := evm.GetTxPriority(txData, baseFeeMicronibiPerGas)
+		priority := evm.GetTxPriority(txData, baseFeeWeiPerGas)
 
 		if priority < minPriority {
 			minPriority = priority
@@ -2,6 +2,8 @@
 package evmante
 
 import (
+	"math/big"
+
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,8 +41,8 @@ func (d MempoolGasPriceDecorator) AnteHandle(
 	}
 
 	minGasPrice := ctx.MinGasPrices().AmountOf(evm.EVMBankDenom)
-	baseFeeMicronibi := d.evmKeeper.BaseFeeMicronibiPerGas(ctx)
-	baseFeeMicronibiDec := math.LegacyNewDecFromBigInt(baseFeeMicronibi)
+	baseFeeWei := d.evmKeeper.BaseFeeWeiPerGas(ctx)
+	baseFeeMicronibiDec := weiToMicronibiDec(baseFeeWei)
 
 	// if MinGasPrices is not set, skip the check
 	if minGasPrice.IsZero() {
@@ -59,9 +61,8 @@ func (d MempoolGasPriceDecorator) AnteHandle(
 			)
 		}
 
-		baseFeeWei := evm.NativeToWei(baseFeeMicronibi)
-		effectiveGasPriceDec := math.LegacyNewDecFromBigInt(
-			evm.WeiToNative(ethTx.EffectiveGasPriceWeiPerGas(baseFeeWei)),
+		effectiveGasPriceDec := weiToMicronibiDec(
+			ethTx.EffectiveGasPriceWeiPerGas(baseFeeWei),
 		)
 		if effectiveGasPriceDec.LT(minGasPrice) {
 			// if sdk.NewDecFromBigInt(effectiveGasPrice).LT(minGasPrice) {
@@ -77,3 +78,9 @@ func (d MempoolGasPriceDecorator) AnteHandle(
 
 	return next(ctx, tx, simulate)
 }
+
+// weiToMicronibiDec converts an amount in wei to micronibi without rounding it
+// to a whole micronibi.
+func weiToMicronibiDec(amountWei *big.Int) math.LegacyDec {
+	return math.LegacyNewDecFromBigIntWithPrec(amountWei, 12)
+}
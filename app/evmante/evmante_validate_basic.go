@@ -89,7 +89,7 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	txFee := sdk.Coins{}
 	txGasLimit := uint64(0)
 
-	baseFeeMicronibi := vbd.evmKeeper.BaseFeeMicronibiPerGas(ctx)
+	baseFeeWei := vbd.evmKeeper.BaseFeeWeiPerGas(ctx)
 
 	for _, msg := range protoTx.GetMsgs() {
 		msgEthTx, ok := msg.(*evm.MsgEthereumTx)
@@ -115,7 +115,7 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 			return ctx, errorsmod.Wrap(err, "failed to unpack MsgEthereumTx Data")
 		}
 
-		if baseFeeMicronibi == nil && txData.TxType() == gethcore.DynamicFeeTxType {
+		if baseFeeWei == nil && txData.TxType() == gethcore.DynamicFeeTxType {
 			return ctx, errorsmod.Wrap(
 				gethcore.ErrTxTypeNotSupported,
 				"dynamic fee tx not supported",
@@ -3,6 +3,7 @@ package app_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,10 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
 )
 
 // init changes the value of 'DefaultTestingAppInit' to use custom initialization.
@@ -203,3 +208,64 @@ func (suite *IBCTestSuite) TestHandleMsgTransfer() {
 	balance = chainCApp.BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom())
 	suite.Require().Zero(balance.Amount.Int64())
 }
+
+// TestIBCTransferPrecompile sends an ICS-20 transfer from an EVM account on
+// chainA using the "IIBCTransfer.sol" precompile and checks that the coins are
+// escrowed on the channel restricted by the "evm_channels" param.
+func (suite *IBCTestSuite) TestIBCTransferPrecompile() {
+	path := NewIBCTestingTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	chainAApp, ok := suite.chainA.App.(*app.NibiruApp)
+	suite.Require().True(ok)
+	// The EVM uses the block proposer as the coinbase address.
+	ctx := suite.chainA.GetContext()
+	proposer, err := chainAApp.StakingKeeper.GetAllValidators(ctx)[0].GetConsAddr()
+	suite.Require().NoError(err)
+	header := ctx.BlockHeader()
+	header.ProposerAddress = proposer
+	ctx = ctx.WithBlockHeader(header)
+
+	params := chainAApp.EvmKeeper.GetParams(ctx)
+	params.EVMChannels = []string{path.EndpointA.ChannelID}
+	suite.Require().NoError(chainAApp.EvmKeeper.SetParams(ctx, params))
+
+	sender := evmtest.NewEthPrivAcc()
+	amount := math.NewInt(69_420)
+	suite.Require().NoError(testapp.FundAccount(
+		chainAApp.BankKeeper, ctx, sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)),
+	))
+
+	timeoutTimestamp := uint64(suite.chainB.CurrentHeader.Time.Add(time.Hour).UnixNano())
+	evmResp, err := chainAApp.EvmKeeper.CallContract(
+		ctx,
+		embeds.SmartContract_IBCTransfer.ABI,
+		sender.EthAddr,
+		&precompile.PrecompileAddr_IBCTransfer,
+		true,
+		evmkeeper.Erc20GasLimitExecute,
+		string(precompile.IBCTransferMethod_transfer),
+		path.EndpointA.ChannelID,
+		sdk.DefaultBondDenom,
+		amount.BigInt(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		timeoutTimestamp,
+	)
+	suite.Require().NoError(err)
+
+	var sequence uint64
+	suite.Require().NoError(embeds.SmartContract_IBCTransfer.ABI.UnpackIntoInterface(
+		&sequence, string(precompile.IBCTransferMethod_transfer), evmResp.Ret,
+	))
+	suite.Equal(uint64(1), sequence)
+
+	escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Equal(
+		amount.String(),
+		chainAApp.BankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom).Amount.String(),
+	)
+	suite.True(
+		chainAApp.BankKeeper.GetBalance(ctx, sender.NibiruAddr, sdk.DefaultBondDenom).IsZero(),
+	)
+}
@@ -11,6 +11,7 @@ import (
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	ibcwasmkeeper "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v7/modules/apps/transfer/keeper"
 
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
@@ -51,6 +52,9 @@ type PublicKeepers struct {
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
 
+	/* IBCTransferKeeper is for cross-chain fungible token transfers (ICS-20). */
+	IBCTransferKeeper ibctransferkeeper.Keeper
+
 	// make IBC modules public for test purposes
 	// these modules are never directly routed to by the IBC Router
 	FeeMockModule ibcmock.IBCModule
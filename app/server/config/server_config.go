@@ -145,6 +145,8 @@ type JSONRPCConfig struct {
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
+	// With the EVM tx indexer enabled, it only applies to the blocks that are
+	// not indexed yet.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
@@ -156,8 +158,14 @@ type JSONRPCConfig struct {
 	// MaxOpenConnections sets the maximum number of simultaneous connections
 	// for the server listener.
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
-	// EnableIndexer defines if enable the custom indexer service.
+	// EnableIndexer defines if enable the custom indexer service. The indexer
+	// also indexes EVM logs to answer `eth_getLogs` queries.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerStartHeight is the block height to backfill the indexer from. The
+	// indexer follows the chain tip and indexes the older blocks down to this
+	// height, or to the earliest block of the node if it is lower. Zero
+	// disables the backfill.
+	IndexerStartHeight int64 `mapstructure:"indexer-start-height"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -248,7 +256,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "net", "txpool", "debug"}
+	return []string{"web3", "eth", "net", "txpool", "debug", "trace", "ots"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -270,6 +278,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		IndexerStartHeight:       0,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -313,6 +322,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.IndexerStartHeight < 0 {
+		return errors.New("JSON-RPC indexer start height cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -455,6 +468,7 @@ feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 logs-cap = {{ .JSONRPC.LogsCap }}
 
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
+# With the EVM tx indexer enabled, it only applies to the blocks that are not indexed yet.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
 # HTTPTimeout is the read/write timeout of http json-rpc server.
@@ -472,8 +486,14 @@ allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
 max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
+# The indexer also indexes EVM logs to answer 'eth_getLogs' queries.
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# IndexerStartHeight is the block height to backfill the EVM indexer from. The indexer follows the
+# chain tip and indexes the older blocks down to this height, or to the earliest block of the node
+# if it is lower. Set it to 1 to index all the blocks of the node, or to 0 to disable the backfill.
+indexer-start-height = {{ .JSONRPC.IndexerStartHeight }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
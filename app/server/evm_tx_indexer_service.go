@@ -3,12 +3,16 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync/atomic"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/cometbft/cometbft/libs/service"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	"github.com/NibiruChain/nibiru/v2/eth/indexer"
 )
@@ -17,20 +21,73 @@ const (
 	EVMTxIndexerServiceName = "EVMTxIndexerService"
 
 	NewBlockWaitTimeout = 60 * time.Second
+
+	// BackfillBatchSize is the number of older blocks the indexer backfills
+	// before it catches up with the chain tip again.
+	BackfillBatchSize = 100
+	// IndexRetryBackoff is the wait before indexing a block again after a
+	// failure. The wait doubles with each failure of the same block, up to
+	// MaxIndexRetryBackoff.
+	IndexRetryBackoff    = time.Second
+	MaxIndexRetryBackoff = time.Minute
 )
 
+// Indexing modes, used as the "mode" label of the indexer metrics.
+const (
+	indexModeTip      = "tip"
+	indexModeBackfill = "backfill"
+)
+
+// errBlockPruned is returned when the node no longer has a block to backfill.
+var errBlockPruned = errors.New("block pruned by the node")
+
 // EVMTxIndexerService indexes transactions for json-rpc service.
+//
+// The service follows the chain tip and, if a start height is set, backfills
+// the older blocks down to it. The indexed blocks always form a contiguous
+// range, which is the persisted progress of the service: on restart, it
+// resumes after the last indexed block and backfills below the first one. A
+// block that fails to be fetched or indexed is retried with backoff, so that
+// no height is skipped. Blocks rolled back by the node after they were indexed
+// are pruned from the indexer and indexed again.
+//
+// The service reports the following telemetry metrics:
+//   - evm_indexer.lag: blocks between the chain tip and the last indexed block
+//   - evm_indexer.backfill_remaining: blocks left to backfill
+//   - evm_indexer.blocks and evm_indexer.txs: counters of the indexed blocks
+//     and of their txs, labeled by mode ("tip" or "backfill")
+//   - evm_indexer.failures: counter of the failed attempts to index a block
+//   - evm_indexer.index_block: time to fetch and index a block
 type EVMTxIndexerService struct {
 	service.BaseService
 
 	evmTxIndexer *indexer.EVMTxIndexer
 	rpcClient    rpcclient.Client
+	// startHeight is the height to backfill the indexer from. Zero disables
+	// the backfill.
+	startHeight int64
+	// retryBackoff is the first wait before indexing a failed block again.
+	retryBackoff time.Duration
 	cancelFunc   context.CancelFunc
+
+	// chainHeight and lastIndexedHeight are shared by the goroutine listening
+	// for new blocks and the indexer loop.
+	chainHeight       atomic.Int64
+	lastIndexedHeight atomic.Int64
+	newBlockSignal    chan struct{}
 }
 
 // NewEVMIndexerService returns a new service instance.
-func NewEVMIndexerService(evmTxIndexer *indexer.EVMTxIndexer, rpcClient rpcclient.Client) *EVMTxIndexerService {
-	indexerService := &EVMTxIndexerService{evmTxIndexer: evmTxIndexer, rpcClient: rpcClient}
+func NewEVMIndexerService(
+	evmTxIndexer *indexer.EVMTxIndexer, rpcClient rpcclient.Client, startHeight int64,
+) *EVMTxIndexerService {
+	indexerService := &EVMTxIndexerService{
+		evmTxIndexer:   evmTxIndexer,
+		rpcClient:      rpcClient,
+		startHeight:    startHeight,
+		retryBackoff:   IndexRetryBackoff,
+		newBlockSignal: make(chan struct{}, 1),
+	}
 	indexerService.BaseService = *service.NewBaseService(nil, EVMTxIndexerServiceName, indexerService)
 	return indexerService
 }
@@ -45,12 +102,30 @@ func (service *EVMTxIndexerService) OnStart() error {
 	if err != nil {
 		return err
 	}
+	service.chainHeight.Store(status.SyncInfo.LatestBlockHeight)
 
-	// chainHeightStorage is used within goroutine and the indexer loop so, using atomic for read/write
-	var chainHeightStorage int64
-	atomic.StoreInt64(&chainHeightStorage, status.SyncInfo.LatestBlockHeight)
+	if err := service.pruneRolledBackBlocks(status.SyncInfo.LatestBlockHeight); err != nil {
+		return err
+	}
+	first, last, err := service.evmTxIndexer.IndexedBlockRange()
+	if err != nil {
+		return err
+	}
+	if last == -1 {
+		// Indexers that predate the stored range of indexed blocks only know
+		// the last block with eth txs.
+		lastTxBlock, err := service.evmTxIndexer.LastIndexedBlock()
+		if err != nil {
+			return err
+		}
+		last = lastTxBlock
+		if last == -1 {
+			last = max(status.SyncInfo.LatestBlockHeight-1, 0)
+		}
+		first = last + 1
+	}
+	service.lastIndexedHeight.Store(last)
 
-	newBlockSignal := make(chan struct{}, 1)
 	blockHeadersChan, err := service.rpcClient.Subscribe(
 		ctx,
 		EVMTxIndexerServiceName,
@@ -67,20 +142,16 @@ func (service *EVMTxIndexerService) OnStart() error {
 			select {
 			case <-ctx.Done():
 				service.Logger.Info("Stopping indexer goroutine")
-				err := service.evmTxIndexer.CloseDBAndExit()
-				if err != nil {
-					service.Logger.Error("Error closing indexer DB", "err", err)
-				}
 				return
 			case msg := <-blockHeadersChan:
 				eventDataHeader := msg.Data.(types.EventDataNewBlockHeader)
 				currentChainHeight := eventDataHeader.Header.Height
-				chainHeight := atomic.LoadInt64(&chainHeightStorage)
-				if currentChainHeight > chainHeight {
-					atomic.StoreInt64(&chainHeightStorage, currentChainHeight)
+				if currentChainHeight > service.chainHeight.Load() {
+					service.chainHeight.Store(currentChainHeight)
+					service.reportLag()
 					// notify
 					select {
-					case newBlockSignal <- struct{}{}:
+					case service.newBlockSignal <- struct{}{}:
 					default:
 					}
 				}
@@ -88,42 +159,161 @@ func (service *EVMTxIndexerService) OnStart() error {
 		}
 	}(ctx)
 
-	lastIndexedHeight, err := service.evmTxIndexer.LastIndexedBlock()
-	if err != nil {
-		return err
-	}
-	if lastIndexedHeight == -1 {
-		lastIndexedHeight = atomic.LoadInt64(&chainHeightStorage)
+	backfillTo := first
+	if service.startHeight > 0 {
+		backfillTo = max(service.startHeight, status.SyncInfo.EarliestBlockHeight)
 	}
+	service.Logger.Info(
+		"starting EVM tx indexer",
+		"next_height", last+1, "backfill_from", first-1, "backfill_to", backfillTo,
+	)
+
+	go service.indexBlocks(ctx, last+1, first-1, backfillTo)
+	return nil
+}
+
+// indexBlocks is the indexer loop. It indexes the blocks from "next" up to the
+// chain tip, and backfills the blocks from "backfillNext" down to
+// "backfillTo" in batches, catching up with the chain tip between batches. It
+// closes the indexer DB when the context is done.
+func (service *EVMTxIndexerService) indexBlocks(
+	ctx context.Context, next, backfillNext, backfillTo int64,
+) {
+	defer func() {
+		if err := service.evmTxIndexer.CloseDBAndExit(); err != nil {
+			service.Logger.Error("Error closing indexer DB", "err", err)
+		}
+	}()
 
-	// Indexer loop
 	for {
-		chainHeight := atomic.LoadInt64(&chainHeightStorage)
-		if chainHeight <= lastIndexedHeight {
-			// nothing to index. wait for signal of new block
-			select {
-			case <-newBlockSignal:
-			case <-time.After(NewBlockWaitTimeout):
+		chainHeight := service.chainHeight.Load()
+		for ; next <= chainHeight; next++ {
+			if err := service.indexBlockWithRetry(ctx, next, indexModeTip); err != nil {
+				return
 			}
-			continue
+			service.lastIndexedHeight.Store(next)
+			service.reportLag()
 		}
-		for i := lastIndexedHeight + 1; i <= chainHeight; i++ {
-			block, err := service.rpcClient.Block(ctx, &i)
-			if err != nil {
-				service.Logger.Error("failed to fetch block", "height", i, "err", err)
-				break
+
+		if backfillNext >= backfillTo {
+			batchEnd := max(backfillNext-BackfillBatchSize+1, backfillTo)
+			for ; backfillNext >= batchEnd; backfillNext-- {
+				err := service.indexBlockWithRetry(ctx, backfillNext, indexModeBackfill)
+				if errors.Is(err, errBlockPruned) {
+					service.Logger.Info("stopping backfill at a block pruned by the node", "height", backfillNext)
+					backfillTo = backfillNext + 1
+					break
+				}
+				if err != nil {
+					return
+				}
 			}
-			blockResult, err := service.rpcClient.BlockResults(ctx, &i)
-			if err != nil {
-				service.Logger.Error("failed to fetch block result", "height", i, "err", err)
-				break
+			telemetry.SetGauge(float32(backfillNext-backfillTo+1), "evm_indexer", "backfill_remaining")
+			if backfillNext < backfillTo {
+				service.Logger.Info("backfill of the EVM tx indexer done", "first_height", backfillTo)
 			}
-			if err := service.evmTxIndexer.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
-				service.Logger.Error("failed to index block", "height", i, "err", err)
+			continue
+		}
+
+		// nothing to index. wait for signal of new block
+		select {
+		case <-ctx.Done():
+			return
+		case <-service.newBlockSignal:
+		case <-time.After(NewBlockWaitTimeout):
+		}
+	}
+}
+
+// indexBlockWithRetry indexes the block at "height", retrying with backoff
+// until it succeeds or the context is done. In backfill mode, it gives up with
+// [errBlockPruned] once the node no longer has the block.
+func (service *EVMTxIndexerService) indexBlockWithRetry(
+	ctx context.Context, height int64, mode string,
+) error {
+	backoff := service.retryBackoff
+	for attempt := 1; ; attempt++ {
+		err := service.indexBlock(ctx, height, mode)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		telemetry.IncrCounterWithLabels(
+			[]string{"evm_indexer", "failures"}, 1,
+			[]metrics.Label{telemetry.NewLabel("mode", mode)},
+		)
+		if mode == indexModeBackfill {
+			status, statusErr := service.rpcClient.Status(ctx)
+			if statusErr == nil && height < status.SyncInfo.EarliestBlockHeight {
+				return errBlockPruned
 			}
-			lastIndexedHeight = blockResult.Height
 		}
+		service.Logger.Error(
+			"failed to index block, retrying",
+			"height", height, "attempt", attempt, "retry_in", backoff, "err", err,
+		)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, MaxIndexRetryBackoff)
+	}
+}
+
+// indexBlock fetches the block at "height" with its results and indexes it.
+func (service *EVMTxIndexerService) indexBlock(ctx context.Context, height int64, mode string) error {
+	defer telemetry.MeasureSince(time.Now(), "evm_indexer", "index_block")
+
+	block, err := service.rpcClient.Block(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block: %w", err)
+	}
+	blockResult, err := service.rpcClient.BlockResults(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block result: %w", err)
 	}
+	if err := service.evmTxIndexer.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+		return err
+	}
+
+	labels := []metrics.Label{telemetry.NewLabel("mode", mode)}
+	telemetry.IncrCounterWithLabels([]string{"evm_indexer", "blocks"}, 1, labels)
+	telemetry.IncrCounterWithLabels(
+		[]string{"evm_indexer", "txs"}, float32(len(block.Block.Txs)), labels,
+	)
+	return nil
+}
+
+// pruneRolledBackBlocks prunes the indexed blocks above the latest height of
+// the node. The node only has lower blocks after a rollback, and the blocks it
+// commits again at the pruned heights may hold other txs.
+func (service *EVMTxIndexerService) pruneRolledBackBlocks(latestHeight int64) error {
+	_, last, err := service.evmTxIndexer.IndexedBlockRange()
+	if err != nil {
+		return err
+	}
+	lastTxBlock, err := service.evmTxIndexer.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if max(last, lastTxBlock) <= latestHeight {
+		return nil
+	}
+	service.Logger.Info(
+		"pruning indexed blocks rolled back by the node",
+		"latest_height", latestHeight, "last_indexed_height", max(last, lastTxBlock),
+	)
+	return service.evmTxIndexer.PruneBlocksAfter(latestHeight)
+}
+
+// reportLag sets the gauge of the number of blocks between the chain tip and
+// the last indexed block.
+func (service *EVMTxIndexerService) reportLag() {
+	lag := service.chainHeight.Load() - service.lastIndexedHeight.Load()
+	telemetry.SetGauge(float32(max(lag, 0)), "evm_indexer", "lag")
 }
 
 func (service *EVMTxIndexerService) OnStop() {
@@ -0,0 +1,206 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/eth/indexer"
+)
+
+// fakeChainClient is a CometBFT RPC client serving empty blocks from
+// "earliest" to "latest". Fetching a block listed in "failures" fails as many
+// times as given. The methods the indexer service doesn't use panic.
+type fakeChainClient struct {
+	rpcclient.Client
+
+	mu       sync.Mutex
+	latest   int64
+	earliest int64
+	failures map[int64]int
+	// onBlock, if set, is called on each fetch of a block, before it fails or
+	// is served.
+	onBlock func(height int64)
+	// fetched are the heights of the blocks served, in order.
+	fetched []int64
+}
+
+func (c *fakeChainClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+		LatestBlockHeight:   c.latest,
+		EarliestBlockHeight: c.earliest,
+	}}, nil
+}
+
+func (c *fakeChainClient) Subscribe(
+	context.Context, string, string, ...int,
+) (<-chan coretypes.ResultEvent, error) {
+	return make(chan coretypes.ResultEvent), nil
+}
+
+func (c *fakeChainClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	if c.onBlock != nil {
+		c.onBlock(*height)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if *height < c.earliest || *height > c.latest {
+		return nil, fmt.Errorf("height %d is not available", *height)
+	}
+	if c.failures[*height] > 0 {
+		c.failures[*height]--
+		return nil, fmt.Errorf("failed to fetch block %d", *height)
+	}
+	return &coretypes.ResultBlock{
+		Block: &tmtypes.Block{Header: tmtypes.Header{Height: *height}},
+	}, nil
+}
+
+func (c *fakeChainClient) BlockResults(
+	_ context.Context, height *int64,
+) (*coretypes.ResultBlockResults, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fetched = append(c.fetched, *height)
+	return &coretypes.ResultBlockResults{Height: *height}, nil
+}
+
+func (c *fakeChainClient) fetchedHeights() []int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]int64{}, c.fetched...)
+}
+
+// heightRange returns the heights from "from" to "to", counting down if "from"
+// is above "to".
+func heightRange(from, to int64) (heights []int64) {
+	step := int64(1)
+	if from > to {
+		step = -1
+	}
+	for h := from; h != to+step; h += step {
+		heights = append(heights, h)
+	}
+	return heights
+}
+
+func TestEVMTxIndexerService(t *testing.T) {
+	newIndexer := func(t *testing.T, indexedFrom, indexedTo int64) *indexer.EVMTxIndexer {
+		idxer := indexer.NewEVMTxIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), client.Context{})
+		for h := indexedFrom; h > 0 && h <= indexedTo; h++ {
+			block := &tmtypes.Block{Header: tmtypes.Header{Height: h}}
+			require.NoError(t, idxer.IndexBlock(block, nil))
+		}
+		return idxer
+	}
+	newService := func(
+		idxer *indexer.EVMTxIndexer, chain *fakeChainClient, startHeight int64,
+	) *EVMTxIndexerService {
+		service := NewEVMIndexerService(idxer, chain, startHeight)
+		service.retryBackoff = time.Millisecond
+		return service
+	}
+	// start starts the service and stops it at the end of the test.
+	start := func(t *testing.T, service *EVMTxIndexerService) {
+		require.NoError(t, service.Start())
+		t.Cleanup(func() { _ = service.Stop() })
+	}
+	// waitForRange waits until the indexed block range is [first, last].
+	waitForRange := func(t *testing.T, idxer *indexer.EVMTxIndexer, first, last int64) {
+		require.Eventually(t, func() bool {
+			gotFirst, gotLast, err := idxer.IndexedBlockRange()
+			require.NoError(t, err)
+			return gotFirst == first && gotLast == last
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("backfill batches alternate with the tip and failed blocks are retried", func(t *testing.T) {
+		idxer := newIndexer(t, 0, 0)
+		chain := &fakeChainClient{
+			latest:   250,
+			earliest: 1,
+			failures: map[int64]int{120: 2, 251: 1},
+		}
+		service := newService(idxer, chain, 1)
+		chain.onBlock = func(height int64) {
+			// Two new blocks while the first backfill batch is indexed.
+			if height == 150 {
+				chain.mu.Lock()
+				chain.latest = 252
+				chain.mu.Unlock()
+				service.chainHeight.Store(252)
+			}
+		}
+		start(t, service)
+		waitForRange(t, idxer, 1, 252)
+
+		var want []int64
+		want = append(want, 250)
+		want = append(want, heightRange(249, 150)...)
+		want = append(want, 251, 252)
+		want = append(want, heightRange(149, 1)...)
+		require.Equal(t, want, chain.fetchedHeights())
+		chain.mu.Lock()
+		require.Equal(t, map[int64]int{120: 0, 251: 0}, chain.failures, "every failure was retried")
+		chain.mu.Unlock()
+	})
+
+	t.Run("backfill stops at a block pruned by the node", func(t *testing.T) {
+		idxer := newIndexer(t, 0, 0)
+		chain := &fakeChainClient{latest: 250, earliest: 1}
+		chain.onBlock = func(height int64) {
+			if height == 200 {
+				chain.mu.Lock()
+				chain.earliest = 180
+				chain.mu.Unlock()
+			}
+		}
+		start(t, newService(idxer, chain, 1))
+		waitForRange(t, idxer, 180, 250)
+
+		want := append([]int64{250}, heightRange(249, 180)...)
+		require.Equal(t, want, chain.fetchedHeights())
+	})
+
+	t.Run("resumes from the indexed block range after a restart", func(t *testing.T) {
+		idxer := newIndexer(t, 100, 150)
+		chain := &fakeChainClient{latest: 200, earliest: 1}
+		start(t, newService(idxer, chain, 90))
+		waitForRange(t, idxer, 90, 200)
+
+		want := append(heightRange(151, 200), heightRange(99, 90)...)
+		require.Equal(t, want, chain.fetchedHeights())
+	})
+
+	t.Run("prunes the blocks rolled back by the node", func(t *testing.T) {
+		idxer := newIndexer(t, 100, 150)
+		chain := &fakeChainClient{latest: 140, earliest: 1}
+		start(t, newService(idxer, chain, 0))
+		waitForRange(t, idxer, 100, 140)
+		require.Empty(t, chain.fetchedHeights())
+	})
+
+	t.Run("indexers without a stored range resume after their last tx", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		require.NoError(t, db.Set(indexer.TxIndexKey(120, 0), common.Hash{}.Bytes()))
+		idxer := indexer.NewEVMTxIndexer(db, tmlog.NewNopLogger(), client.Context{})
+		chain := &fakeChainClient{latest: 130, earliest: 1}
+		start(t, newService(idxer, chain, 0))
+		waitForRange(t, idxer, 121, 130)
+
+		require.Equal(t, heightRange(121, 130), chain.fetchedHeights())
+	})
+}
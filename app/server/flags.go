@@ -50,6 +50,7 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCIndexerStartHeight  = "json-rpc.indexer-start-height"
 	JSONRPCEnableMetrics       = "metrics"
 )
 
@@ -197,6 +197,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Int64(JSONRPCIndexerStartHeight, 0, "Backfill the custom tx indexer from this block height (1 for the earliest block of the node, 0 to disable)")
 	cmd.Flags().Bool(JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -389,7 +390,7 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, opts Start
 			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-		evmTxIndexer, _, err := OpenEVMIndexer(ctx, idxDB, clientCtx)
+		evmTxIndexer, _, err := OpenEVMIndexer(ctx, idxDB, clientCtx, conf.JSONRPC.IndexerStartHeight)
 		if err != nil {
 			logger.Error("failed starting evm indexer service", "error", err.Error())
 			return err
@@ -600,12 +601,12 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 }
 
 func OpenEVMIndexer(
-	ctx *sdkserver.Context, indexerDb dbm.DB, clientCtx client.Context,
+	ctx *sdkserver.Context, indexerDb dbm.DB, clientCtx client.Context, startHeight int64,
 ) (eth.EVMTxIndexer, *EVMTxIndexerService, error) {
 	idxLogger := ctx.Logger.With("indexer", "evm")
 	evmIndexer := indexer.NewEVMTxIndexer(indexerDb, idxLogger, clientCtx)
 
-	evmIndexerService := NewEVMIndexerService(evmIndexer, clientCtx.Client.(rpcclient.Client))
+	evmIndexerService := NewEVMIndexerService(evmIndexer, clientCtx.Client.(rpcclient.Client), startHeight)
 	evmIndexerService.SetLogger(idxLogger)
 
 	errCh := make(chan error)
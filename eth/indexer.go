@@ -5,6 +5,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
@@ -17,4 +18,27 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// GetTxHashesByAddress returns the hashes of the txs sent by, sent to, or
+	// creating the contract at an address in a block range, and whether the
+	// range has more txs past the returned ones. The range is bounded by the
+	// indexed block range.
+	GetTxHashesByAddress(
+		addr common.Address, fromBlock, toBlock int64, reverse bool, limit int,
+	) (hashes []common.Hash, hasMore bool, err error)
+	// GetTxHashBySenderAndNonce returns nil if tx not found.
+	GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error)
+	// GetContractCreationTxHash returns nil if tx not found.
+	GetContractCreationTxHash(contract common.Address) (*common.Hash, error)
+
+	// IndexedBlockRange returns -1, -1 if no block was indexed.
+	IndexedBlockRange() (first, last int64, err error)
+	// GetLogs returns the eth logs in a block range that match the addresses
+	// and topics of a filter. Returns an error if more than "limit" logs match.
+	GetLogs(
+		fromBlock, toBlock int64,
+		addresses []common.Address,
+		topics [][]common.Hash,
+		limit int,
+	) ([]*gethcore.Log, error)
 }
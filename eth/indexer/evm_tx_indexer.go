@@ -14,6 +14,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
@@ -21,11 +23,23 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash          = 1
+	KeyPrefixTxIndex         = 2
+	KeyPrefixAddrTx          = 3
+	KeyPrefixSenderNonce     = 4
+	KeyPrefixContractCreator = 5
+	KeyPrefixLog             = 6
+	KeyPrefixLogAddr         = 7
+	KeyPrefixLogTopic        = 8
+	KeyPrefixIndexedBlocks   = 9
+	KeyPrefixTxAddrKeys      = 10
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// AddrTxKeyLength is the length of the address-tx key
+	AddrTxKeyLength = 1 + common.AddressLength + 8 + 8
+	// LogKeyLength is the length of the log key
+	LogKeyLength = 1 + logPositionLength
 )
 
 var _ eth.EVMTxIndexer = &EVMTxIndexer{}
@@ -47,6 +61,8 @@ func NewEVMTxIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *EV
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores indexer.TxResult based on parsed events for every message
+// - Indexes every message by its sender, recipient and created contract
+// - Indexes every eth log of the block by its address and topics
 func (indexer *EVMTxIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
 
@@ -124,8 +140,24 @@ func (indexer *EVMTxIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.
 			if err := saveTxResult(indexer.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+
+			ethTx := ethMsg.AsTransaction()
+			sender, err := txSender(ethTx)
+			if err != nil {
+				indexer.logger.Error("Fail to recover tx sender", "err", err, "block", height, "txHash", txHash.Hex())
+				continue
+			}
+			if err := saveAddressIndexes(batch, ethTx, sender, &txResult); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
 		}
 	}
+	if err := indexer.saveBlockLogs(batch, txResults); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if err := indexer.saveIndexedBlock(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -142,6 +174,69 @@ func (indexer *EVMTxIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(indexer.db)
 }
 
+// PruneBlocksAfter deletes everything indexed for the blocks above "height"
+// and shrinks the range of indexed blocks to end at "height". It is used when
+// the node rolled back blocks that were already indexed, since the blocks
+// committed again at those heights may hold other txs.
+func (indexer *EVMTxIndexer) PruneBlocksAfter(height int64) error {
+	first, last, err := indexer.IndexedBlockRange()
+	if err != nil {
+		return err
+	}
+	lastTxBlock, err := indexer.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if last <= height && lastTxBlock <= height {
+		return nil
+	}
+
+	batch := indexer.db.NewBatch()
+	defer batch.Close()
+
+	// Delete the txs of the pruned blocks and collect their hashes, to find
+	// their entries in the indexes that aren't keyed by block number.
+	prunedTxs := make(map[common.Hash]struct{})
+	it, err := indexer.db.Iterator(TxIndexKey(height+1, 0), []byte{KeyPrefixTxIndex + 1})
+	if err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocksAfter %d", height)
+	}
+	for ; it.Valid(); it.Next() {
+		txHash := common.BytesToHash(it.Value())
+		prunedTxs[txHash] = struct{}{}
+		if err := batch.Delete(it.Key()); err != nil {
+			it.Close()
+			return errorsmod.Wrapf(err, "PruneBlocksAfter %d", height)
+		}
+		if err := batch.Delete(TxHashKey(txHash)); err != nil {
+			it.Close()
+			return errorsmod.Wrapf(err, "PruneBlocksAfter %d", height)
+		}
+	}
+	it.Close()
+
+	if err := indexer.pruneAddressIndexes(batch, prunedTxs); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocksAfter %d", height)
+	}
+	if err := indexer.pruneBlockLogs(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocksAfter %d", height)
+	}
+
+	if first > height {
+		err = batch.Delete(IndexedBlocksKey())
+	} else if last > height {
+		bz := append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(height))...)
+		err = batch.Set(IndexedBlocksKey(), bz)
+	}
+	if err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocksAfter %d", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocksAfter %d, write batch", height)
+	}
+	return nil
+}
+
 // GetByTxHash finds eth tx by eth tx hash
 func (indexer *EVMTxIndexer) GetByTxHash(hash common.Hash) (*eth.TxResult, error) {
 	bz, err := indexer.db.Get(TxHashKey(hash))
@@ -170,6 +265,99 @@ func (indexer *EVMTxIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32
 	return indexer.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetTxHashesByAddress returns the hashes of the eth txs in blocks [fromBlock,
+// toBlock] that were sent by "addr", sent to "addr" or that created the
+// contract at "addr". The hashes are ordered by block and by eth tx index,
+// from the newest if "reverse" is true, and from the oldest otherwise.
+//
+// At most "limit" hashes are returned, except that the txs of a block are
+// never split: once the limit is reached, the remaining txs of the last block
+// are still returned. "hasMore" reports whether the range contains more txs
+// past the returned ones. The limit must be positive.
+//
+// The range is bounded by [EVMTxIndexer.IndexedBlockRange], like the logs of
+// "eth_getLogs": the address indexes of the blocks indexed before they existed
+// are incomplete, so the history of an address starts at the first indexed
+// block. Older blocks are indexed by backfilling the indexer with the
+// "json-rpc.indexer-start-height" setting.
+func (indexer *EVMTxIndexer) GetTxHashesByAddress(
+	addr common.Address, fromBlock, toBlock int64, reverse bool, limit int,
+) (hashes []common.Hash, hasMore bool, err error) {
+	if limit < 1 {
+		return nil, false, fmt.Errorf("GetTxHashesByAddress %s: limit must be positive, got %d", addr.Hex(), limit)
+	}
+	first, last, err := indexer.IndexedBlockRange()
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetTxHashesByAddress %s", addr.Hex())
+	}
+	fromBlock = max(fromBlock, first, 0)
+	toBlock = min(toBlock, last)
+	if toBlock < fromBlock {
+		return nil, false, nil
+	}
+	start := AddrTxKey(addr, fromBlock, 0)
+	end := AddrTxKey(addr, toBlock+1, 0)
+
+	var it dbm.Iterator
+	if reverse {
+		it, err = indexer.db.ReverseIterator(start, end)
+	} else {
+		it, err = indexer.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetTxHashesByAddress %s", addr.Hex())
+	}
+	defer it.Close()
+
+	lastHeight := int64(-1)
+	for ; it.Valid(); it.Next() {
+		height, err := parseBlockNumberFromAddrTxKey(it.Key())
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(err, "GetTxHashesByAddress %s", addr.Hex())
+		}
+		if len(hashes) >= limit && height != lastHeight {
+			return hashes, true, nil
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+		lastHeight = height
+	}
+	return hashes, false, nil
+}
+
+// GetTxHashBySenderAndNonce finds the hash of the eth tx sent by "sender" with
+// the given nonce. Returns nil if the tx is not found.
+func (indexer *EVMTxIndexer) GetTxHashBySenderAndNonce(
+	sender common.Address, nonce uint64,
+) (*common.Hash, error) {
+	bz, err := indexer.db.Get(SenderNonceKey(sender, nonce))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetTxHashBySenderAndNonce %s %d", sender.Hex(), nonce)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	txHash := common.BytesToHash(bz)
+	return &txHash, nil
+}
+
+// GetContractCreationTxHash finds the hash of the eth tx that deployed the
+// contract at "contract". Only contracts deployed by a contract creation tx are
+// indexed, not the ones created by another contract. Returns nil if the tx is
+// not found.
+func (indexer *EVMTxIndexer) GetContractCreationTxHash(
+	contract common.Address,
+) (*common.Hash, error) {
+	bz, err := indexer.db.Get(ContractCreatorKey(contract))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreationTxHash %s", contract.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	txHash := common.BytesToHash(bz)
+	return &txHash, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -182,6 +370,32 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// AddrTxKey returns the key for db entry:
+// `(address, block number, tx index) -> tx hash`
+func AddrTxKey(addr common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))
+	key := append([]byte{KeyPrefixAddrTx}, addr.Bytes()...)
+	return append(append(key, bz1...), bz2...)
+}
+
+// SenderNonceKey returns the key for db entry: `(sender, nonce) -> tx hash`
+func SenderNonceKey(sender common.Address, nonce uint64) []byte {
+	key := append([]byte{KeyPrefixSenderNonce}, sender.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// ContractCreatorKey returns the key for db entry: `contract address -> tx hash`
+func ContractCreatorKey(contract common.Address) []byte {
+	return append([]byte{KeyPrefixContractCreator}, contract.Bytes()...)
+}
+
+// TxAddrKeysKey returns the key for db entry:
+// `tx hash -> address index keys of the tx`
+func TxAddrKeysKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixTxAddrKeys}, hash.Bytes()...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -243,6 +457,99 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveAddressIndexes indexes the eth tx by its sender, recipient and created
+// contract into the kv db batch. The created contract is only indexed if the tx
+// succeeded. The keys written for the tx are saved under [TxAddrKeysKey], so
+// that pruning the tx doesn't have to look for them.
+func saveAddressIndexes(
+	batch dbm.Batch, tx *gethcore.Transaction, sender common.Address, txResult *eth.TxResult,
+) error {
+	txHash := tx.Hash()
+
+	keys := [][]byte{
+		AddrTxKey(sender, txResult.Height, txResult.EthTxIndex),
+		SenderNonceKey(sender, tx.Nonce()),
+	}
+	if to := tx.To(); to != nil {
+		keys = append(keys, AddrTxKey(*to, txResult.Height, txResult.EthTxIndex))
+	} else if !txResult.Failed {
+		contract := crypto.CreateAddress(sender, tx.Nonce())
+		keys = append(keys,
+			AddrTxKey(contract, txResult.Height, txResult.EthTxIndex),
+			ContractCreatorKey(contract),
+		)
+	}
+	var txAddrKeys []byte
+	for _, key := range keys {
+		if err := batch.Set(key, txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set address index key")
+		}
+		txAddrKeys = append(append(txAddrKeys, byte(len(key))), key...)
+	}
+	if err := batch.Set(TxAddrKeysKey(txHash), txAddrKeys); err != nil {
+		return errorsmod.Wrap(err, "set tx-address-keys key")
+	}
+	return nil
+}
+
+// txSender recovers the sender of a signed eth tx. Msgs in a valid tx have an
+// empty "From", so the sender comes from the signature.
+func txSender(tx *gethcore.Transaction) (common.Address, error) {
+	var signer gethcore.Signer = gethcore.HomesteadSigner{}
+	if tx.Protected() {
+		signer = gethcore.LatestSignerForChainID(tx.ChainId())
+	}
+	return gethcore.Sender(signer, tx)
+}
+
+// pruneAddressIndexes deletes the address index entries of the pruned txs in
+// the kv db batch, using the keys saved for each tx by [saveAddressIndexes].
+// The sender-nonce and contract-creator entries are only deleted if they
+// still point to the pruned tx.
+func (indexer *EVMTxIndexer) pruneAddressIndexes(
+	batch dbm.Batch, prunedTxs map[common.Hash]struct{},
+) error {
+	for txHash := range prunedTxs {
+		txAddrKeys, err := indexer.db.Get(TxAddrKeysKey(txHash))
+		if err != nil {
+			return err
+		}
+		for len(txAddrKeys) > 0 {
+			keyLen := int(txAddrKeys[0])
+			if keyLen == 0 || len(txAddrKeys) < 1+keyLen {
+				return fmt.Errorf("corrupted address index keys of tx %s", txHash.Hex())
+			}
+			key := txAddrKeys[1 : 1+keyLen]
+			txAddrKeys = txAddrKeys[1+keyLen:]
+
+			if key[0] != KeyPrefixAddrTx {
+				bz, err := indexer.db.Get(key)
+				if err != nil {
+					return err
+				}
+				if common.BytesToHash(bz) != txHash {
+					continue
+				}
+			}
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+		}
+		if err := batch.Delete(TxAddrKeysKey(txHash)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseBlockNumberFromAddrTxKey(key []byte) (int64, error) {
+	if len(key) != AddrTxKeyLength {
+		return 0, fmt.Errorf("wrong address-tx key length, expect: %d, got: %d", AddrTxKeyLength, len(key))
+	}
+	offset := 1 + common.AddressLength
+	return int64(sdk.BigEndianToUint64(key[offset : offset+8])), nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
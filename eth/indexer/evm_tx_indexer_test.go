@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -9,8 +10,10 @@ import (
 	tmlog "github.com/cometbft/cometbft/libs/log"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/v2/app"
@@ -198,3 +201,361 @@ func TestEVMTxIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestEVMTxIndexerAddressIndexes(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := evmtest.NewSigner(priv)
+	ethSigner := gethcore.LatestSignerForChainID(nil)
+
+	encCfg := app.MakeEncodingConfig()
+	eth.RegisterInterfaces(encCfg.InterfaceRegistry)
+	evm.RegisterInterfaces(encCfg.InterfaceRegistry)
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+
+	// newBlock builds a block with a single eth tx and its tx result.
+	newBlock := func(
+		height int64, nonce uint64, to *common.Address,
+	) (*tmtypes.Block, []*abci.ResponseDeliverTx, common.Hash) {
+		tx := evm.NewTx(&evm.EvmTxArgs{
+			Nonce:    nonce,
+			To:       to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 100_000,
+		})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		cosmosTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), eth.EthBaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(cosmosTx)
+		require.NoError(t, err)
+
+		block := &tmtypes.Block{
+			Header: tmtypes.Header{Height: height},
+			Data:   tmtypes.Data{Txs: []tmtypes.Tx{txBz}},
+		}
+		txResults := []*abci.ResponseDeliverTx{{
+			Code: 0,
+			Events: []abci.Event{{
+				Type: evm.PendingEthereumTxEvent,
+				Attributes: []abci.EventAttribute{
+					{Key: evm.PendingEthereumTxEventAttrEthHash, Value: txHash.Hex()},
+					{Key: evm.PendingEthereumTxEventAttrIndex, Value: "0"},
+				},
+			}},
+		}}
+		return block, txResults, txHash
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewEVMTxIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	// Block 1: contract creation, blocks 2-4: transfers to "alice"
+	alice := common.BigToAddress(big.NewInt(1))
+	contract := crypto.CreateAddress(from, 0)
+	var txHashes []common.Hash
+	for i := int64(1); i <= 4; i++ {
+		to := &alice
+		if i == 1 {
+			to = nil
+		}
+		block, txResults, txHash := newBlock(i, uint64(i-1), to)
+		require.NoError(t, idxer.IndexBlock(block, txResults))
+		txHashes = append(txHashes, txHash)
+	}
+
+	t.Run("by sender", func(t *testing.T) {
+		hashes, hasMore, err := idxer.GetTxHashesByAddress(from, 0, 10, false, 10)
+		require.NoError(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, txHashes, hashes)
+
+		hashes, hasMore, err = idxer.GetTxHashesByAddress(from, 0, 10, true, 10)
+		require.NoError(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, []common.Hash{txHashes[3], txHashes[2], txHashes[1], txHashes[0]}, hashes)
+	})
+
+	t.Run("by recipient, paginated", func(t *testing.T) {
+		hashes, hasMore, err := idxer.GetTxHashesByAddress(alice, 0, 10, true, 2)
+		require.NoError(t, err)
+		require.True(t, hasMore)
+		require.Equal(t, []common.Hash{txHashes[3], txHashes[2]}, hashes)
+
+		hashes, hasMore, err = idxer.GetTxHashesByAddress(alice, 0, 2, true, 2)
+		require.NoError(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, []common.Hash{txHashes[1]}, hashes)
+
+		hashes, hasMore, err = idxer.GetTxHashesByAddress(alice, 3, 3, false, 2)
+		require.NoError(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, []common.Hash{txHashes[2]}, hashes)
+
+		_, _, err = idxer.GetTxHashesByAddress(alice, 0, 10, true, 0)
+		require.ErrorContains(t, err, "limit must be positive")
+	})
+
+	t.Run("by created contract", func(t *testing.T) {
+		hashes, _, err := idxer.GetTxHashesByAddress(contract, 0, 10, false, 10)
+		require.NoError(t, err)
+		require.Equal(t, []common.Hash{txHashes[0]}, hashes)
+
+		creationTxHash, err := idxer.GetContractCreationTxHash(contract)
+		require.NoError(t, err)
+		require.NotNil(t, creationTxHash)
+		require.Equal(t, txHashes[0], *creationTxHash)
+
+		creationTxHash, err = idxer.GetContractCreationTxHash(alice)
+		require.NoError(t, err)
+		require.Nil(t, creationTxHash)
+	})
+
+	t.Run("history is bounded by the indexed block range", func(t *testing.T) {
+		// An address entry below the indexed block range, as left by an
+		// indexer that predates the stored range.
+		legacyTxHash := common.BigToHash(big.NewInt(0xdead))
+		require.NoError(t, db.Set(indexer.AddrTxKey(alice, 0, 0), legacyTxHash.Bytes()))
+		defer func() { require.NoError(t, db.Delete(indexer.AddrTxKey(alice, 0, 0))) }()
+
+		hashes, hasMore, err := idxer.GetTxHashesByAddress(alice, 0, 10, true, 10)
+		require.NoError(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, []common.Hash{txHashes[3], txHashes[2], txHashes[1]}, hashes)
+	})
+
+	t.Run("by sender and nonce", func(t *testing.T) {
+		for nonce, wantHash := range txHashes {
+			txHash, err := idxer.GetTxHashBySenderAndNonce(from, uint64(nonce))
+			require.NoError(t, err)
+			require.NotNil(t, txHash)
+			require.Equal(t, wantHash, *txHash)
+		}
+		txHash, err := idxer.GetTxHashBySenderAndNonce(from, 4)
+		require.NoError(t, err)
+		require.Nil(t, txHash)
+	})
+
+	t.Run("address indexes don't change the indexed block range", func(t *testing.T) {
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), first)
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(4), last)
+	})
+
+	t.Run("prune blocks after a height", func(t *testing.T) {
+		require.NoError(t, idxer.PruneBlocksAfter(2))
+
+		first, last, err := idxer.IndexedBlockRange()
+		require.NoError(t, err)
+		require.Equal(t, []int64{1, 2}, []int64{first, last})
+		lastTxBlock, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), lastTxBlock)
+
+		for i, txHash := range txHashes {
+			_, err := idxer.GetByTxHash(txHash)
+			nonceTxHash, nonceErr := idxer.GetTxHashBySenderAndNonce(from, uint64(i))
+			require.NoError(t, nonceErr)
+			hasAddrKeys, hasErr := db.Has(indexer.TxAddrKeysKey(txHash))
+			require.NoError(t, hasErr)
+			if i < 2 {
+				require.NoError(t, err)
+				require.Equal(t, txHash, *nonceTxHash)
+				require.True(t, hasAddrKeys)
+			} else {
+				require.Error(t, err)
+				require.Nil(t, nonceTxHash)
+				require.False(t, hasAddrKeys)
+			}
+		}
+
+		hashes, _, err := idxer.GetTxHashesByAddress(from, 0, 10, false, 10)
+		require.NoError(t, err)
+		require.Equal(t, txHashes[:2], hashes)
+		hashes, _, err = idxer.GetTxHashesByAddress(alice, 0, 10, false, 10)
+		require.NoError(t, err)
+		require.Equal(t, txHashes[1:2], hashes)
+
+		creationTxHash, err := idxer.GetContractCreationTxHash(contract)
+		require.NoError(t, err)
+		require.Equal(t, txHashes[0], *creationTxHash)
+
+		// Pruning above the last indexed block changes nothing.
+		require.NoError(t, idxer.PruneBlocksAfter(5))
+		first, last, err = idxer.IndexedBlockRange()
+		require.NoError(t, err)
+		require.Equal(t, []int64{1, 2}, []int64{first, last})
+
+		// Pruning below the first indexed block empties the indexer.
+		require.NoError(t, idxer.PruneBlocksAfter(0))
+		first, last, err = idxer.IndexedBlockRange()
+		require.NoError(t, err)
+		require.Equal(t, []int64{-1, -1}, []int64{first, last})
+		creationTxHash, err = idxer.GetContractCreationTxHash(contract)
+		require.NoError(t, err)
+		require.Nil(t, creationTxHash)
+	})
+}
+
+func TestEVMTxIndexerLogs(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	eth.RegisterInterfaces(encCfg.InterfaceRegistry)
+	evm.RegisterInterfaces(encCfg.InterfaceRegistry)
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+
+	var (
+		tokenA   = common.BigToAddress(big.NewInt(0xa))
+		tokenB   = common.BigToAddress(big.NewInt(0xb))
+		transfer = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+		approval = crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+		alice    = common.BytesToHash(common.BigToAddress(big.NewInt(1)).Bytes())
+		bob      = common.BytesToHash(common.BigToAddress(big.NewInt(2)).Bytes())
+	)
+
+	// newLog builds the log with the given position in the chain.
+	newLog := func(
+		height int64, index uint, addr common.Address, topics ...common.Hash,
+	) *gethcore.Log {
+		return &gethcore.Log{
+			Address:     addr,
+			Topics:      topics,
+			Data:        []byte{byte(index)},
+			BlockNumber: uint64(height),
+			TxHash:      common.BigToHash(big.NewInt(height)),
+			Index:       index,
+			BlockHash:   common.BigToHash(big.NewInt(height * 100)),
+		}
+	}
+	// txResult builds a tx result that emits the logs.
+	txResult := func(logs ...*gethcore.Log) *abci.ResponseDeliverTx {
+		var txLogs []string
+		for _, log := range logs {
+			bz, err := json.Marshal(evm.NewLogFromEth(log))
+			require.NoError(t, err)
+			txLogs = append(txLogs, string(bz))
+		}
+		event, err := sdk.TypedEventToEvent(&evm.EventTxLog{TxLogs: txLogs})
+		require.NoError(t, err)
+		return &abci.ResponseDeliverTx{Events: []abci.Event{abci.Event(event)}}
+	}
+
+	logs := []*gethcore.Log{
+		newLog(1, 0, tokenA, transfer, alice, bob),
+		newLog(1, 1, tokenB, approval, bob, alice),
+		newLog(3, 0, tokenB, transfer, bob, alice),
+		newLog(3, 1, tokenA, transfer, alice, alice),
+	}
+	blocks := map[int64][]*abci.ResponseDeliverTx{
+		1: {txResult(logs[0]), txResult(logs[1])},
+		2: {},
+		3: {txResult(logs[2], logs[3])},
+	}
+
+	idxer := indexer.NewEVMTxIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	first, last, err := idxer.IndexedBlockRange()
+	require.NoError(t, err)
+	require.Equal(t, []int64{-1, -1}, []int64{first, last})
+	for height := int64(1); height <= 3; height++ {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, blocks[height]))
+	}
+
+	t.Run("indexed block range includes blocks without logs", func(t *testing.T) {
+		first, last, err := idxer.IndexedBlockRange()
+		require.NoError(t, err)
+		require.Equal(t, []int64{1, 3}, []int64{first, last})
+	})
+
+	for _, tc := range []struct {
+		name      string
+		fromBlock int64
+		toBlock   int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		want      []*gethcore.Log
+	}{
+		{
+			name: "no filter", fromBlock: 0, toBlock: 10,
+			want: logs,
+		},
+		{
+			name: "block range", fromBlock: 2, toBlock: 3,
+			want: logs[2:],
+		},
+		{
+			name: "by address", fromBlock: 0, toBlock: 10,
+			addresses: []common.Address{tokenA},
+			want:      []*gethcore.Log{logs[0], logs[3]},
+		},
+		{
+			name: "by addresses and topic", fromBlock: 0, toBlock: 10,
+			addresses: []common.Address{tokenA, tokenB},
+			topics:    [][]common.Hash{{transfer}},
+			want:      []*gethcore.Log{logs[0], logs[2], logs[3]},
+		},
+		{
+			name: "by first topic", fromBlock: 0, toBlock: 10,
+			topics: [][]common.Hash{{approval}},
+			want:   []*gethcore.Log{logs[1]},
+		},
+		{
+			name: "by later topics", fromBlock: 0, toBlock: 10,
+			topics: [][]common.Hash{{}, {alice}, {bob, alice}},
+			want:   []*gethcore.Log{logs[0], logs[3]},
+		},
+		{
+			name: "by topic, matching nothing", fromBlock: 0, toBlock: 10,
+			topics: [][]common.Hash{{transfer}, {}, {}, {alice}},
+			want:   []*gethcore.Log{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := idxer.GetLogs(tc.fromBlock, tc.toBlock, tc.addresses, tc.topics, 100)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("sad: more logs than the limit", func(t *testing.T) {
+		_, err := idxer.GetLogs(0, 10, nil, nil, 3)
+		require.ErrorContains(t, err, "query returned more than 3 results")
+		_, err = idxer.GetLogs(0, 10, []common.Address{tokenA}, nil, 1)
+		require.ErrorContains(t, err, "query returned more than 1 results")
+	})
+
+	t.Run("prune blocks after a height", func(t *testing.T) {
+		require.NoError(t, idxer.PruneBlocksAfter(2))
+		first, last, err := idxer.IndexedBlockRange()
+		require.NoError(t, err)
+		require.Equal(t, []int64{1, 2}, []int64{first, last})
+
+		for _, filter := range []struct {
+			addresses []common.Address
+			topics    [][]common.Hash
+		}{
+			{},
+			{addresses: []common.Address{tokenA, tokenB}},
+			{topics: [][]common.Hash{{transfer}}},
+			{topics: [][]common.Hash{{}, {bob}}},
+		} {
+			got, err := idxer.GetLogs(0, 10, filter.addresses, filter.topics, 100)
+			require.NoError(t, err)
+			for _, log := range got {
+				require.EqualValues(t, 1, log.BlockNumber)
+			}
+		}
+		got, err := idxer.GetLogs(0, 10, nil, nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, logs[:2], got)
+	})
+}
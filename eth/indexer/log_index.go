@@ -0,0 +1,347 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// The logs of a block are stored under their position in the chain, the
+// block number and the log index in the block, and are indexed by address and
+// by topic with empty entries whose keys end with that position:
+//
+//   - `(block number, log index) -> log`
+//   - `(address, block number, log index) -> nil`
+//   - `(topic position, topic, block number, log index) -> nil`
+//
+// The range of indexed blocks is stored under a single key so that queries can
+// tell which blocks the indexer has seen, including blocks without logs.
+
+// logPositionLength is the length of the (block number, log index) suffix of
+// the log keys.
+const logPositionLength = 8 + 8
+
+// maxIndexedTopics is the number of topic positions indexed for each log,
+// which is the maximum number of topics of an EVM log.
+const maxIndexedTopics = 4
+
+// IndexedBlockRange returns the first and the last block numbers seen by the
+// indexer. Returns -1, -1 if no block was indexed.
+func (indexer *EVMTxIndexer) IndexedBlockRange() (first, last int64, err error) {
+	bz, err := indexer.db.Get(IndexedBlocksKey())
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "IndexedBlockRange")
+	}
+	if len(bz) != 16 {
+		return -1, -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz[:8])), int64(sdk.BigEndianToUint64(bz[8:])), nil
+}
+
+// GetLogs returns the eth logs in blocks [fromBlock, toBlock] that match the
+// addresses and topics, with the semantics of the "eth_getLogs" filter
+// criteria. The logs are ordered by block number and log index. Returns an
+// error if more than "limit" logs match.
+//
+// The logs are read from the address index if addresses are given, from the
+// index of the first non-empty topic position otherwise, and from the whole
+// range of logs if neither is given.
+func (indexer *EVMTxIndexer) GetLogs(
+	fromBlock, toBlock int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*gethcore.Log, error) {
+	if fromBlock < 0 {
+		fromBlock = 0
+	}
+	logs := []*gethcore.Log{}
+	if toBlock < fromBlock {
+		return logs, nil
+	}
+
+	var prefixes [][]byte
+	if len(addresses) > 0 {
+		for _, addr := range addresses {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogAddr}, addr.Bytes()...))
+		}
+	} else {
+		for i, sub := range topics {
+			if len(sub) == 0 || i >= maxIndexedTopics {
+				continue
+			}
+			for _, topic := range sub {
+				prefixes = append(prefixes, logTopicPrefix(i, topic))
+			}
+			break
+		}
+	}
+
+	appendLog := func(bz []byte) error {
+		log, err := indexer.unmarshalLog(bz)
+		if err != nil {
+			return err
+		}
+		if !logMatches(log, addresses, topics) {
+			return nil
+		}
+		if len(logs) >= limit {
+			return fmt.Errorf("query returned more than %d results", limit)
+		}
+		logs = append(logs, log)
+		return nil
+	}
+
+	// Without an index to use, walk the logs of the range.
+	if len(prefixes) == 0 {
+		it, err := indexer.db.Iterator(LogKey(fromBlock, 0), LogKey(toBlock+1, 0))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			if err := appendLog(it.Value()); err != nil {
+				return nil, errorsmod.Wrap(err, "GetLogs")
+			}
+		}
+		return logs, nil
+	}
+
+	// Collect the positions of the logs of each index entry, then load the
+	// logs in chain order.
+	positions := make(map[string]struct{})
+	for _, prefix := range prefixes {
+		if err := indexer.collectLogPositions(prefix, fromBlock, toBlock, positions); err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+	}
+	sorted := make([]string, 0, len(positions))
+	for position := range positions {
+		sorted = append(sorted, position)
+	}
+	sort.Strings(sorted)
+	for _, position := range sorted {
+		bz, err := indexer.db.Get(append([]byte{KeyPrefixLog}, position...))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		if len(bz) == 0 {
+			continue
+		}
+		if err := appendLog(bz); err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+	}
+	return logs, nil
+}
+
+// collectLogPositions adds the (block number, log index) positions of the
+// index entries under "prefix" in blocks [fromBlock, toBlock] to "positions".
+func (indexer *EVMTxIndexer) collectLogPositions(
+	prefix []byte, fromBlock, toBlock int64, positions map[string]struct{},
+) error {
+	start := append(bytes.Clone(prefix), logPosition(fromBlock, 0)...)
+	end := append(bytes.Clone(prefix), logPosition(toBlock+1, 0)...)
+	it, err := indexer.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != len(prefix)+logPositionLength {
+			return fmt.Errorf("wrong log index key length, expect: %d, got: %d", len(prefix)+logPositionLength, len(key))
+		}
+		positions[string(key[len(prefix):])] = struct{}{}
+	}
+	return nil
+}
+
+func (indexer *EVMTxIndexer) unmarshalLog(bz []byte) (*gethcore.Log, error) {
+	var log evm.Log
+	if err := indexer.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+		return nil, err
+	}
+	return log.ToEthereum(), nil
+}
+
+// LogKey returns the key for db entry: `(block number, log index) -> log`
+func LogKey(blockNumber int64, logIndex uint64) []byte {
+	return append([]byte{KeyPrefixLog}, logPosition(blockNumber, logIndex)...)
+}
+
+// LogAddrKey returns the key for db entry:
+// `(address, block number, log index) -> nil`
+func LogAddrKey(addr common.Address, blockNumber int64, logIndex uint64) []byte {
+	key := append([]byte{KeyPrefixLogAddr}, addr.Bytes()...)
+	return append(key, logPosition(blockNumber, logIndex)...)
+}
+
+// LogTopicKey returns the key for db entry:
+// `(topic position, topic, block number, log index) -> nil`
+func LogTopicKey(topicIndex int, topic common.Hash, blockNumber int64, logIndex uint64) []byte {
+	return append(logTopicPrefix(topicIndex, topic), logPosition(blockNumber, logIndex)...)
+}
+
+// IndexedBlocksKey returns the key for db entry:
+// `-> (first block number, last block number)`
+func IndexedBlocksKey() []byte {
+	return []byte{KeyPrefixIndexedBlocks}
+}
+
+func logTopicPrefix(topicIndex int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(topicIndex)}, topic.Bytes()...)
+}
+
+func logPosition(blockNumber int64, logIndex uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(blockNumber)), sdk.Uint64ToBigEndian(logIndex)...)
+}
+
+// saveBlockLogs indexes the eth logs emitted by the txs of a block into the kv
+// db batch.
+func (indexer *EVMTxIndexer) saveBlockLogs(batch dbm.Batch, txResults []*abci.ResponseDeliverTx) error {
+	for _, result := range txResults {
+		logs, err := txLogsFromEvents(result.Events)
+		if err != nil {
+			return err
+		}
+		for _, log := range logs {
+			height := int64(log.BlockNumber)
+			if err := batch.Set(LogKey(height, log.Index), indexer.clientCtx.Codec.MustMarshal(log)); err != nil {
+				return errorsmod.Wrap(err, "set log key")
+			}
+			addr := common.HexToAddress(log.Address)
+			if err := batch.Set(LogAddrKey(addr, height, log.Index), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-address key")
+			}
+			for i, topic := range log.Topics {
+				if i >= maxIndexedTopics {
+					break
+				}
+				key := LogTopicKey(i, common.HexToHash(topic), height, log.Index)
+				if err := batch.Set(key, []byte{}); err != nil {
+					return errorsmod.Wrap(err, "set log-topic key")
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// pruneBlockLogs deletes the eth logs of the blocks above "height", with their
+// address and topic entries, in the kv db batch.
+func (indexer *EVMTxIndexer) pruneBlockLogs(batch dbm.Batch, height int64) error {
+	it, err := indexer.db.Iterator(LogKey(height+1, 0), []byte{KeyPrefixLog + 1})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		log, err := indexer.unmarshalLog(it.Value())
+		if err != nil {
+			return err
+		}
+		blockNumber, logIndex := int64(log.BlockNumber), uint64(log.Index)
+		keys := [][]byte{it.Key(), LogAddrKey(log.Address, blockNumber, logIndex)}
+		for i, topic := range log.Topics {
+			if i >= maxIndexedTopics {
+				break
+			}
+			keys = append(keys, LogTopicKey(i, topic, blockNumber, logIndex))
+		}
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				return errorsmod.Wrap(err, "delete log key")
+			}
+		}
+	}
+	return nil
+}
+
+// saveIndexedBlock extends the range of indexed blocks with "height" in the kv
+// db batch.
+func (indexer *EVMTxIndexer) saveIndexedBlock(batch dbm.Batch, height int64) error {
+	first, last, err := indexer.IndexedBlockRange()
+	if err != nil {
+		return err
+	}
+	if first < 0 || height < first {
+		first = height
+	}
+	if height > last {
+		last = height
+	}
+	bz := append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...)
+	if err := batch.Set(IndexedBlocksKey(), bz); err != nil {
+		return errorsmod.Wrap(err, "set indexed-blocks key")
+	}
+	return nil
+}
+
+// txLogsFromEvents parses the eth logs of all the [evm.EventTxLog] events of a
+// tx result.
+func txLogsFromEvents(events []abci.Event) ([]*evm.Log, error) {
+	var logs []*evm.Log
+	for _, event := range events {
+		if event.Type != evm.TypeUrlEventTxLog {
+			continue
+		}
+		eventTxLog, err := evm.EventTxLogFromABCIEvent(event)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to parse event tx log")
+		}
+		for _, logString := range eventTxLog.TxLogs {
+			var log evm.Log
+			if err := json.Unmarshal([]byte(logString), &log); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to unmarshal event tx log")
+			}
+			logs = append(logs, &log)
+		}
+	}
+	return logs, nil
+}
+
+// logMatches returns true if the log matches the addresses and topics of a
+// filter. An empty list of addresses or of topics at a position matches
+// anything.
+func logMatches(log *gethcore.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, addr := range addresses {
+			if addr == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		match := len(sub) == 0
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
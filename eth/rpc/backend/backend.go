@@ -55,6 +55,12 @@ func NewBackend(
 	}
 }
 
+// EVMTxIndexer returns the EVM tx indexer of the node, or nil if the indexer is
+// disabled with "json-rpc.enable-indexer".
+func (b *Backend) EVMTxIndexer() eth.EVMTxIndexer {
+	return b.evmTxIndexer
+}
+
 // CosmosBackend: Currently unused. Backend functionality for the shared
 // "cosmos" RPC namespace. Implements [BackendI] in combination with [Backend].
 // TODO: feat(eth): Implement the cosmos JSON-RPC defined by [Wallet Connect V2].
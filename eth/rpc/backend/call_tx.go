@@ -196,7 +196,7 @@ func (b *Backend) SetTxDefaults(args evm.JsonTxArgs) (evm.JsonTxArgs, error) {
 		}
 
 		blockNr := rpc.EthPendingBlockNumber
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil)
 		if err != nil {
 			return args, err
 		}
@@ -212,8 +212,9 @@ func (b *Backend) SetTxDefaults(args evm.JsonTxArgs) (evm.JsonTxArgs, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
+// The optional state overrides are applied before the estimate is made.
 func (b *Backend) EstimateGas(
-	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber, overrides *rpc.StateOverride,
 ) (hexutil.Uint64, error) {
 	blockNr := rpc.EthPendingBlockNumber
 	if blockNrOptional != nil {
@@ -224,6 +225,10 @@ func (b *Backend) EstimateGas(
 	if err != nil {
 		return 0, err
 	}
+	overridesBz, err := marshalStateOverride(overrides)
+	if err != nil {
+		return 0, err
+	}
 
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
@@ -236,6 +241,7 @@ func (b *Backend) EstimateGas(
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -249,14 +255,19 @@ func (b *Backend) EstimateGas(
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
+// estimated gas used on the operation or an error if fails. The optional state
+// overrides are applied before the call is executed.
 func (b *Backend) DoCall(
-	args evm.JsonTxArgs, blockNr rpc.BlockNumber,
+	args evm.JsonTxArgs, blockNr rpc.BlockNumber, overrides *rpc.StateOverride,
 ) (*evm.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
+	overridesBz, err := marshalStateOverride(overrides)
+	if err != nil {
+		return nil, err
+	}
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -268,6 +279,7 @@ func (b *Backend) DoCall(
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -304,6 +316,171 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// CreateAccessList returns the EIP-2930 access list of the accounts and storage
+// slots accessed by the given call, together with the gas used by the call
+// when it is sent with that access list. The optional state overrides are
+// applied before the call is executed.
+func (b *Backend) CreateAccessList(
+	args evm.JsonTxArgs, blockNr rpc.BlockNumber, overrides *rpc.StateOverride,
+) (*rpc.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	overridesBz, err := marshalStateOverride(overrides)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evm.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpc.NewContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	accessList := res.AccessList.ToEthAccessList()
+	if *accessList == nil {
+		// marshal an empty access list as "[]" rather than "null"
+		accessList = &gethcore.AccessList{}
+	}
+	return &rpc.AccessListResult{
+		AccessList: accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// SimulateV1 runs the calls of the simulated blocks of an "eth_simulateV1"
+// request on top of the given block and returns the simulated blocks, each
+// with the results of its calls. A simulation aborted by an invalid block or
+// tx fails with an [evm.SimError] that carries its JSON-RPC error code.
+func (b *Backend) SimulateV1(
+	opts evm.SimOpts, blockNr rpc.BlockNumber,
+) ([]map[string]any, error) {
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evm.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	ctx := rpc.NewContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	if res.ErrorCode != 0 {
+		return nil, &evm.SimError{Code: int(res.ErrorCode), Message: res.Error}
+	}
+
+	var simBlocks []evm.SimulatedBlock
+	if err := json.Unmarshal(res.Blocks, &simBlocks); err != nil {
+		return nil, err
+	}
+	blocks := make([]map[string]any, 0, len(simBlocks))
+	for _, simBlock := range simBlocks {
+		block, err := b.formatSimulatedBlock(simBlock, opts.ReturnFullTransactions)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// formatSimulatedBlock returns the JSON-RPC block object of a simulated block,
+// with the results of its calls in "calls".
+func (b *Backend) formatSimulatedBlock(
+	simBlock evm.SimulatedBlock, fullTx bool,
+) (map[string]any, error) {
+	header := simBlock.Header
+	headerBz, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	var block map[string]any
+	if err := json.Unmarshal(headerBz, &block); err != nil {
+		return nil, err
+	}
+
+	blockHash := header.Hash()
+	txs := make(gethcore.Transactions, 0, len(simBlock.Txs))
+	rpcTxs := make([]any, 0, len(simBlock.Txs))
+	for i, simTx := range simBlock.Txs {
+		tx := new(gethcore.Transaction)
+		if err := tx.UnmarshalBinary(simTx.Tx); err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+		if !fullTx {
+			rpcTxs = append(rpcTxs, tx.Hash())
+			continue
+		}
+		rpcTx, err := rpc.NewRPCTxFromEthTx(
+			tx, blockHash, header.Number.Uint64(), uint64(i), header.BaseFee, b.chainID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		// The txs are unsigned, so the sender comes from the call.
+		rpcTx.From = simTx.From
+		rpcTxs = append(rpcTxs, rpcTx)
+	}
+
+	block["transactions"] = rpcTxs
+	block["calls"] = simBlock.Calls
+	block["uncles"] = []common.Hash{}
+	block["totalDifficulty"] = (*hexutil.Big)(big.NewInt(0))
+	block["size"] = hexutil.Uint64(gethcore.NewBlockWithHeader(header).WithBody(txs, nil).Size())
+	return block, nil
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -311,17 +488,14 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 		err    error
 	)
 
-	head, err := b.CurrentHeader()
-	if err != nil {
-		return nil, err
-	}
-
-	if head.BaseFee != nil {
-		result, err = b.SuggestGasTipCap(head.BaseFee)
+	// The latest state holds the base fee of the next (pending) block, which is
+	// the block a tx sent now would be included in.
+	if baseFee := b.baseFeeWeiAtHeight(0); baseFee != nil {
+		result, err = b.SuggestGasTipCap(baseFee)
 		if err != nil {
 			return nil, err
 		}
-		result = result.Add(result, head.BaseFee)
+		result = result.Add(result, baseFee)
 	} else {
 		result = big.NewInt(b.RPCMinGasPrice())
 	}
@@ -338,3 +512,12 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 
 	return (*hexutil.Big)(result), nil
 }
+
+// marshalStateOverride encodes the state overrides for an [evm.EthCallRequest].
+// It returns nil if there are no overrides.
+func marshalStateOverride(overrides *rpc.StateOverride) ([]byte, error) {
+	if overrides == nil || len(*overrides) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(overrides)
+}
@@ -1,9 +1,11 @@
 package backend_test
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
@@ -76,12 +78,75 @@ func (s *BackendSuite) TestDoCall() {
 		To:    &recipient,
 		Value: (*hexutil.Big)(evm.NativeToWei(big.NewInt(1))),
 	}
-	txResponse, err := s.backend.DoCall(jsonTxArgs, rpc.EthPendingBlockNumber)
+	txResponse, err := s.backend.DoCall(jsonTxArgs, rpc.EthPendingBlockNumber, nil)
 	s.Require().NoError(err)
 	s.Require().NotNil(txResponse)
 	s.Require().Greater(txResponse.GasUsed, uint64(0))
 }
 
+func (s *BackendSuite) TestCreateAccessList() {
+	jsonTxArgs := evm.JsonTxArgs{
+		From:  &s.fundedAccEthAddr,
+		To:    &recipient,
+		Value: (*hexutil.Big)(evm.NativeToWei(big.NewInt(1))),
+	}
+	res, err := s.backend.CreateAccessList(jsonTxArgs, rpc.EthPendingBlockNumber, nil)
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+	s.Empty(res.Error)
+	s.Equal(hexutil.Uint64(params.TxGas), res.GasUsed)
+
+	// A plain transfer accesses no storage, so the access list is empty.
+	s.Require().NotNil(res.AccessList)
+	s.Empty(*res.AccessList)
+	bz, err := json.Marshal(res)
+	s.Require().NoError(err)
+	s.Contains(string(bz), `"accessList":[]`)
+}
+
+func (s *BackendSuite) TestSimulateV1() {
+	transfer := evm.JsonTxArgs{
+		From:  &s.fundedAccEthAddr,
+		To:    &recipient,
+		Value: (*hexutil.Big)(evm.NativeToWei(big.NewInt(1))),
+	}
+	opts := evm.SimOpts{
+		ReturnFullTransactions: true,
+		BlockStateCalls: []evm.SimBlock{
+			{Calls: []evm.JsonTxArgs{transfer, transfer}},
+		},
+	}
+	blocks, err := s.backend.SimulateV1(opts, rpc.EthLatestBlockNumber)
+	s.Require().NoError(err)
+	s.Require().Len(blocks, 1)
+
+	block := blocks[0]
+	txs, ok := block["transactions"].([]any)
+	s.Require().True(ok)
+	s.Require().Len(txs, 2)
+	rpcTx, ok := txs[1].(*rpc.EthTxJsonRPC)
+	s.Require().True(ok)
+	s.Equal(s.fundedAccEthAddr, rpcTx.From)
+	s.Equal(block["hash"], rpcTx.BlockHash.Hex())
+
+	calls, ok := block["calls"].([]evm.SimCallResult)
+	s.Require().True(ok)
+	s.Require().Len(calls, 2)
+	for _, call := range calls {
+		s.Nil(call.Error)
+		s.Equal(hexutil.Uint64(params.TxGas), call.GasUsed)
+	}
+	s.Equal(hexutil.Uint64(2*params.TxGas).String(), block["gasUsed"])
+
+	s.Run("sad: simulation error has a json rpc error code", func() {
+		opts := evm.SimOpts{BlockStateCalls: make([]evm.SimBlock, evm.MaxSimulateBlocks+1)}
+		_, err := s.backend.SimulateV1(opts, rpc.EthLatestBlockNumber)
+		var simErr *evm.SimError
+		s.Require().ErrorAs(err, &simErr)
+		s.Equal(evm.SimErrCodeClientLimit, simErr.ErrorCode())
+	})
+}
+
 func (s *BackendSuite) TestGasPrice() {
 	gasPrice, err := s.backend.GasPrice()
 	s.Require().NoError(err)
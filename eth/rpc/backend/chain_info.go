@@ -33,16 +33,36 @@ func (b *Backend) ChainConfig() *params.ChainConfig {
 	return evm.EthereumConfig(b.chainID)
 }
 
-// BaseFeeWei returns the EIP-1559 base fee.
-// If the base fee is not enabled globally, the query returns nil.
+// BaseFeeWei returns the EIP-1559 base fee in effect for the block. The base
+// fee of a block is set at the end of its parent block, so it is read from the
+// state of the parent. If the base fee can't be queried, it returns nil.
 func (b *Backend) BaseFeeWei(
 	blockRes *tmrpctypes.ResultBlockResults,
 ) (baseFeeWei *big.Int, err error) {
-	res, err := b.queryClient.BaseFee(rpc.NewContextWithHeight(blockRes.Height), &evm.QueryBaseFeeRequest{})
+	height := blockRes.Height
+	if height > 1 {
+		height--
+	}
+	return b.baseFeeWeiAtHeight(height), nil
+}
+
+// NextBaseFeeWei returns the EIP-1559 base fee of the block after the given
+// one, which is set at the end of the given block. If the base fee can't be
+// queried, it returns nil.
+func (b *Backend) NextBaseFeeWei(
+	blockRes *tmrpctypes.ResultBlockResults,
+) (baseFeeWei *big.Int, err error) {
+	return b.baseFeeWeiAtHeight(blockRes.Height), nil
+}
+
+// baseFeeWeiAtHeight queries the base fee stored in the state at the given
+// height, which is the base fee of the next block.
+func (b *Backend) baseFeeWeiAtHeight(height int64) *big.Int {
+	res, err := b.queryClient.BaseFee(rpc.NewContextWithHeight(height), &evm.QueryBaseFeeRequest{})
 	if err != nil || res.BaseFee == nil {
-		return nil, nil
+		return nil
 	}
-	return res.BaseFee.BigInt(), nil
+	return res.BaseFee.BigInt()
 }
 
 // CurrentHeader returns the latest block header
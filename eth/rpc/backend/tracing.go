@@ -148,25 +148,7 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 		return []*evm.TxTraceResult{}, nil
 	}
 
-	txDecoder := b.clientCtx.TxConfig.TxDecoder()
-
-	var txsMessages []*evm.MsgEthereumTx
-	for i, tx := range txs {
-		decodedTx, err := txDecoder(tx)
-		if err != nil {
-			b.logger.Error("failed to decode transaction", "hash", txs[i].Hash(), "error", err.Error())
-			continue
-		}
-
-		for _, msg := range decodedTx.GetMsgs() {
-			ethMessage, ok := msg.(*evm.MsgEthereumTx)
-			if !ok {
-				// Just considers Ethereum transactions
-				continue
-			}
-			txsMessages = append(txsMessages, ethMessage)
-		}
-	}
+	txsMessages := b.TraceableEthMsgs(block)
 
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
@@ -210,6 +192,33 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 	return decodedResults, nil
 }
 
+// TraceableEthMsgs returns the MsgEthereumTxs of the block in the order they
+// are traced by [Backend.TraceBlock]. Unlike [Backend.EthMsgsFromTendermintBlock],
+// it doesn't exclude txs that failed before execution.
+func (b *Backend) TraceableEthMsgs(block *tmrpctypes.ResultBlock) []*evm.MsgEthereumTx {
+	txs := block.Block.Txs
+	txDecoder := b.clientCtx.TxConfig.TxDecoder()
+
+	var txsMessages []*evm.MsgEthereumTx
+	for i, tx := range txs {
+		decodedTx, err := txDecoder(tx)
+		if err != nil {
+			b.logger.Error("failed to decode transaction", "hash", txs[i].Hash(), "error", err.Error())
+			continue
+		}
+
+		for _, msg := range decodedTx.GetMsgs() {
+			ethMessage, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				// Just considers Ethereum transactions
+				continue
+			}
+			txsMessages = append(txsMessages, ethMessage)
+		}
+	}
+	return txsMessages
+}
+
 // TraceCall implements eth debug_traceCall method which lets you run an eth_call
 // within the context of the given block execution using the final state of parent block as the base.
 // Method returns the structured logs created during the execution of EVM.
@@ -195,18 +195,127 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 	}
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evm.MsgEthereumTx)
 
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	if res.EthTxIndex == -1 {
+		// Fallback to find tx index by iterating all valid eth transactions
+		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+		for i := range msgs {
+			if msgs[i].Hash == hexTx {
+				res.EthTxIndex = int32(i) // #nosec G701
+				break
+			}
+		}
+	}
+	// return error if still unable to find the eth tx index
+	if res.EthTxIndex == -1 {
+		return nil, errors.New("can't find index of ethereum tx")
+	}
+
+	return b.receiptFromTxResult(ethMsg, res, resBlock, blockRes, b.receiptBaseFeeWei(blockRes))
+}
+
+// GetBlockReceipts returns the receipts of all Ethereum txs in the given block,
+// in the same format as [Backend.GetTransactionReceipt]. The block results are
+// fetched once and shared by all receipts of the block. If the block is not
+// found, this resolves to nil.
+func (b *Backend) GetBlockReceipts(
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]*TransactionReceipt, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("block not found", "height", blockNum, "error", err.Error())
+		return nil, nil
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+	height := resBlock.Block.Height
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", height, "error", err.Error())
+		return nil, nil
+	}
+	baseFeeWei := b.receiptBaseFeeWei(blockRes)
+
+	receipts := []*TransactionReceipt{}
+	ethTxIndex := int32(0)
+	for txIndex, txBz := range resBlock.Block.Txs {
+		txResult := blockRes.TxsResults[txIndex]
+		if isValidEnough, _ := rpc.TxIsValidEnough(txResult); !isValidEnough {
+			continue
+		}
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+		parsedTxs, err := rpc.ParseTxResult(txResult, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tx events: block %d, index %d, %w", height, txIndex, err)
+		}
+
+		msgIndex := 0
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+			if parsedTx == nil {
+				return nil, fmt.Errorf("ethereum tx not found in msgs: block %d, index %d", height, txIndex)
+			}
+			if parsedTx.EthTxIndex != -1 {
+				ethTxIndex = parsedTx.EthTxIndex
+			}
+			res := &eth.TxResult{
+				Height:            height,
+				TxIndex:           uint32(txIndex),  // #nosec G701
+				MsgIndex:          uint32(msgIndex), // #nosec G701
+				EthTxIndex:        ethTxIndex,
+				Failed:            parsedTx.Failed,
+				GasUsed:           parsedTx.GasUsed,
+				CumulativeGasUsed: parsedTxs.AccumulativeGasUsed(msgIndex),
+			}
+			receipt, err := b.receiptFromTxResult(ethMsg, res, resBlock, blockRes, baseFeeWei)
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+			msgIndex++
+			ethTxIndex++
+		}
+	}
+	return receipts, nil
+}
+
+// receiptFromTxResult builds the receipt of an Ethereum tx from its indexed
+// result and the CometBFT block and block results that include it. The
+// baseFeeWei is used for the effective gas price of dynamic fee txs and may
+// be nil if it is not available.
+func (b *Backend) receiptFromTxResult(
+	ethMsg *evm.MsgEthereumTx,
+	res *eth.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	baseFeeWei *big.Int,
+) (*TransactionReceipt, error) {
 	txData, err := evm.UnpackTxData(ethMsg.Data)
 	if err != nil {
 		b.logger.Error("failed to unpack tx data", "error", err.Error())
 		return nil, err
 	}
+	txHash := ethMsg.AsTransaction().Hash()
 
 	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
-	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
-		return nil, nil
-	}
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
 	}
@@ -217,9 +326,7 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 		status = gethcore.ReceiptStatusFailed
 	}
 
-	chainID := b.ChainID()
-
-	from, err := ethMsg.GetSender(chainID.ToInt())
+	from, err := ethMsg.GetSender(b.chainID)
 	if err != nil {
 		return nil, err
 	}
@@ -228,22 +335,7 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 	msgIndex := int(res.MsgIndex) // #nosec G701 -- checked for int overflow already
 	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
 	if err != nil {
-		b.logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
-	}
-
-	if res.EthTxIndex == -1 {
-		// Fallback to find tx index by iterating all valid eth transactions
-		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash == hexTx {
-				res.EthTxIndex = int32(i) // #nosec G701
-				break
-			}
-		}
-	}
-	// return error if still unable to find the eth tx index
-	if res.EthTxIndex == -1 {
-		return nil, errors.New("can't find index of ethereum tx")
+		b.logger.Debug("failed to parse logs", "hash", txHash.Hex(), "error", err.Error())
 	}
 
 	receipt := TransactionReceipt{
@@ -258,7 +350,7 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 
 			// Implementation fields: These fields are added by geth when processing a transaction.
 			// They are stored in the chain database.
-			TxHash:  hash,
+			TxHash:  txHash,
 			GasUsed: res.GasUsed,
 
 			BlockHash:        gethcommon.BytesToHash(resBlock.Block.Header.Hash()),
@@ -280,18 +372,24 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 		receipt.ContractAddress = &addr
 	}
 
-	if dynamicTx, ok := txData.(*evm.DynamicFeeTx); ok {
-		baseFeeWei, err := b.BaseFeeWei(blockRes)
-		if err != nil {
-			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt.EffectiveGasPrice = (*hexutil.Big)(dynamicTx.EffectiveGasPriceWeiPerGas(baseFeeWei))
-		}
+	if dynamicTx, ok := txData.(*evm.DynamicFeeTx); ok && baseFeeWei != nil {
+		receipt.EffectiveGasPrice = (*hexutil.Big)(dynamicTx.EffectiveGasPriceWeiPerGas(baseFeeWei))
 	}
 	return &receipt, nil
 }
 
+// receiptBaseFeeWei returns the base fee of the block for the effective gas
+// price of receipts, or nil if it can't be queried.
+func (b *Backend) receiptBaseFeeWei(blockRes *tmrpctypes.ResultBlockResults) *big.Int {
+	baseFeeWei, err := b.BaseFeeWei(blockRes)
+	if err != nil {
+		// tolerate the error for pruned node.
+		b.logger.Error("fetch basefee failed, node is pruned?", "height", blockRes.Height, "error", err)
+		return nil
+	}
+	return baseFeeWei
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (b *Backend) GetTransactionByBlockHashAndIndex(hash gethcommon.Hash, idx hexutil.Uint) (*rpc.EthTxJsonRPC, error) {
 	b.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
@@ -88,6 +88,53 @@ func (s *BackendSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (s *BackendSuite) TestGetBlockReceipts() {
+	blockWithTx, err := s.backend.GetBlockByNumber(transferTxBlockNumber, false)
+	s.Require().NoError(err)
+	blockHash := gethcommon.BytesToHash(blockWithTx["hash"].(hexutil.Bytes))
+
+	wantReceipt, err := s.backend.GetTransactionReceipt(transferTxHash)
+	s.Require().NoError(err)
+	wantReceiptJson, err := wantReceipt.MarshalJSON()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name          string
+		blockNrOrHash rpc.BlockNumberOrHash
+	}{
+		{
+			name:          "happy: by block number",
+			blockNrOrHash: rpc.BlockNumberOrHash{BlockNumber: &transferTxBlockNumber},
+		},
+		{
+			name:          "happy: by block hash",
+			blockNrOrHash: rpc.BlockNumberOrHash{BlockHash: &blockHash},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			receipts, err := s.backend.GetBlockReceipts(tc.blockNrOrHash)
+			s.Require().NoError(err)
+			s.Require().NotEmpty(receipts)
+
+			var gotReceipt *backend.TransactionReceipt
+			for _, receipt := range receipts {
+				s.Equal(blockHash, receipt.BlockHash)
+				if receipt.TxHash == transferTxHash {
+					gotReceipt = receipt
+				}
+			}
+			s.Require().NotNil(gotReceipt, "receipt of transfer tx not found")
+
+			// Same JSON as the single receipt path
+			gotReceiptJson, err := gotReceipt.MarshalJSON()
+			s.Require().NoError(err)
+			s.JSONEq(string(wantReceiptJson), string(gotReceiptJson))
+		})
+	}
+}
+
 func (s *BackendSuite) TestGetTransactionByBlockHashAndIndex() {
 	blockWithTx, err := s.backend.GetBlockByNumber(transferTxBlockNumber, false)
 	s.Require().NoError(err)
@@ -0,0 +1,112 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package backend
+
+import (
+	"sort"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// TxPoolContent holds the Ethereum txs in the mempool, grouped by sender and
+// nonce.
+//   - Pending: Txs that are executable in order, starting from the committed
+//     nonce of the sender.
+//   - Queued: Txs that come after a nonce gap and can't be executed until the
+//     gap is filled.
+type TxPoolContent struct {
+	Pending map[gethcommon.Address]map[uint64]*rpc.EthTxJsonRPC
+	Queued  map[gethcommon.Address]map[uint64]*rpc.EthTxJsonRPC
+}
+
+// TxPoolContent decodes the [evm.MsgEthereumTx] messages in the CometBFT
+// mempool and splits them into pending and queued txs. A sender's txs are
+// split by [SplitTxsByNonce] with the nonce returned by
+// [Backend.GetTransactionCount] at the latest block.
+func (b *Backend) TxPoolContent() (*TxPoolContent, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	txsBySender := make(map[gethcommon.Address]map[uint64]*rpc.EthTxJsonRPC)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+			rpcTx, err := rpc.NewRPCTxFromMsg(
+				ethMsg,
+				gethcommon.Hash{},
+				uint64(0),
+				uint64(0),
+				nil,
+				b.chainID,
+			)
+			if err != nil {
+				b.logger.Debug("failed to decode mempool tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			if txsBySender[rpcTx.From] == nil {
+				txsBySender[rpcTx.From] = make(map[uint64]*rpc.EthTxJsonRPC)
+			}
+			txsBySender[rpcTx.From][uint64(rpcTx.Nonce)] = rpcTx
+		}
+	}
+
+	content := &TxPoolContent{
+		Pending: make(map[gethcommon.Address]map[uint64]*rpc.EthTxJsonRPC),
+		Queued:  make(map[gethcommon.Address]map[uint64]*rpc.EthTxJsonRPC),
+	}
+	for sender, txsByNonce := range txsBySender {
+		committedNonce, err := b.GetTransactionCount(sender, rpc.EthLatestBlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		pending, queued := SplitTxsByNonce(txsByNonce, uint64(*committedNonce))
+		if len(pending) > 0 {
+			content.Pending[sender] = pending
+		}
+		if len(queued) > 0 {
+			content.Queued[sender] = queued
+		}
+	}
+	return content, nil
+}
+
+// SplitTxsByNonce splits the mempool txs of a sender, keyed by nonce, into
+// pending and queued txs. The txs are pending as long as their nonces are
+// contiguous with the committed nonce of the sender. Everything after the
+// first nonce gap is queued. Txs with a nonce below the committed one were
+// included in a block but not yet evicted by the mempool recheck, so they are
+// skipped, as in geth.
+func SplitTxsByNonce(
+	txsByNonce map[uint64]*rpc.EthTxJsonRPC, committedNonce uint64,
+) (pending, queued map[uint64]*rpc.EthTxJsonRPC) {
+	nonces := make([]uint64, 0, len(txsByNonce))
+	for nonce := range txsByNonce {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	pending = make(map[uint64]*rpc.EthTxJsonRPC)
+	queued = make(map[uint64]*rpc.EthTxJsonRPC)
+	nextNonce := committedNonce
+	for _, nonce := range nonces {
+		switch {
+		case nonce < committedNonce:
+			continue
+		case nonce == nextNonce:
+			pending[nonce] = txsByNonce[nonce]
+			nextNonce++
+		default:
+			queued[nonce] = txsByNonce[nonce]
+		}
+	}
+	return pending, queued
+}
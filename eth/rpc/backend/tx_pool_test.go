@@ -0,0 +1,91 @@
+package backend_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+func (s *BackendSuite) TestTxPoolContent() {
+	// Create pending tx: don't wait for next block
+	randomEthAddr := evmtest.NewEthPrivAcc().EthAddr
+	txHash := s.SendNibiViaEthTransfer(randomEthAddr, big.NewInt(123), false)
+
+	content, err := s.backend.TxPoolContent()
+	s.Require().NoError(err)
+	s.Require().NotNil(content)
+
+	txFound := false
+	for _, tx := range content.Pending[s.fundedAccEthAddr] {
+		if tx.Hash == txHash {
+			txFound = true
+			s.Equal(s.fundedAccEthAddr, tx.From)
+			s.Equal(randomEthAddr, *tx.To)
+			s.Nil(tx.BlockHash)
+		}
+	}
+	s.Require().True(txFound, "pending tx not found")
+	s.Empty(content.Queued[s.fundedAccEthAddr])
+}
+
+func (s *BackendSuite) TestSplitTxsByNonce() {
+	txsWithNonces := func(nonces ...uint64) map[uint64]*rpc.EthTxJsonRPC {
+		txs := make(map[uint64]*rpc.EthTxJsonRPC)
+		for _, nonce := range nonces {
+			txs[nonce] = &rpc.EthTxJsonRPC{Nonce: hexutil.Uint64(nonce)}
+		}
+		return txs
+	}
+	noncesOf := func(txs map[uint64]*rpc.EthTxJsonRPC) (nonces []uint64) {
+		for nonce := range txs {
+			nonces = append(nonces, nonce)
+		}
+		return nonces
+	}
+
+	for _, tc := range []struct {
+		name           string
+		nonces         []uint64
+		committedNonce uint64
+		wantPending    []uint64
+		wantQueued     []uint64
+	}{
+		{
+			name:           "contiguous nonces are pending",
+			nonces:         []uint64{5, 6, 7},
+			committedNonce: 5,
+			wantPending:    []uint64{5, 6, 7},
+		},
+		{
+			name:           "nonces after a gap are queued",
+			nonces:         []uint64{5, 7, 8},
+			committedNonce: 5,
+			wantPending:    []uint64{5},
+			wantQueued:     []uint64{7, 8},
+		},
+		{
+			name:           "gap at the committed nonce",
+			nonces:         []uint64{6},
+			committedNonce: 5,
+			wantQueued:     []uint64{6},
+		},
+		{
+			name:           "stale nonces are skipped",
+			nonces:         []uint64{3, 4, 5, 6},
+			committedNonce: 5,
+			wantPending:    []uint64{5, 6},
+		},
+	} {
+		s.Run(tc.name, func() {
+			pending, queued := backend.SplitTxsByNonce(
+				txsWithNonces(tc.nonces...), tc.committedNonce,
+			)
+			s.ElementsMatch(tc.wantPending, noncesOf(pending))
+			s.ElementsMatch(tc.wantQueued, noncesOf(queued))
+		})
+	}
+}
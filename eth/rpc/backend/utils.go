@@ -17,7 +17,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/consensus/misc"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -122,12 +121,11 @@ func (b *Backend) retrieveEVMTxFeesFromBlock(
 
 	// set basefee
 	targetOneFeeHistory.BaseFee = blockBaseFee
-	cfg := b.ChainConfig()
-	header, err := b.CurrentHeader()
+	nextBaseFee, err := b.NextBaseFeeWei(tendermintBlockResult)
 	if err != nil {
 		return err
 	}
-	targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, header)
+	targetOneFeeHistory.NextBaseFee = nextBaseFee
 
 	// set gas used ratio
 	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
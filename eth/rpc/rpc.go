@@ -12,6 +12,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -84,6 +85,17 @@ func EthHeaderFromTendermint(
 	}
 }
 
+// SyncProgressFromTendermint converts the sync info of a node that is catching
+// up to the sync progress returned by "eth_syncing". CometBFT doesn't know the
+// highest block of its peers or the number of state entries, so
+// "highestBlock", "pulledStates" and "knownStates" are left out.
+func SyncProgressFromTendermint(syncInfo tmrpctypes.SyncInfo) map[string]any {
+	return map[string]any{
+		"startingBlock": hexutil.Uint64(syncInfo.EarliestBlockHeight),
+		"currentBlock":  hexutil.Uint64(syncInfo.LatestBlockHeight),
+	}
+}
+
 // BlockMaxGasFromConsensusParams returns the gas limit for the current block
 // from the chain consensus params.
 func BlockMaxGasFromConsensusParams(
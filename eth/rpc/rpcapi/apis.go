@@ -10,6 +10,8 @@ import (
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi/debugapi"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi/otsapi"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi/traceapi"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 )
@@ -25,6 +27,8 @@ const (
 	NamespaceNet    = "net"
 	NamespaceTxPool = "txpool"
 	NamespaceDebug  = "debug"
+	NamespaceTrace  = "trace"
+	NamespaceOts    = "ots"
 
 	apiVersion = "1.0"
 )
@@ -85,12 +89,18 @@ func init() {
 				},
 			}
 		},
-		NamespaceTxPool: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ eth.EVMTxIndexer) []rpc.API {
+		NamespaceTxPool: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: NamespaceTxPool,
 					Version:   apiVersion,
-					Service:   NewImplTxPoolAPI(ctx.Logger),
+					Service:   NewImplTxPoolAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -111,6 +121,38 @@ func init() {
 				},
 			}
 		},
+		NamespaceTrace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: NamespaceTrace,
+					Version:   apiVersion,
+					Service:   traceapi.NewImplTraceAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		NamespaceOts: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: NamespaceOts,
+					Version:   apiVersion,
+					Service:   otsapi.NewImplOtsAPI(ctx, evmBackend, indexer),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -44,7 +44,7 @@ type IEthAPI interface {
 	GetTransactionReceipt(hash common.Hash) (*backend.TransactionReceipt, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpc.EthTxJsonRPC, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpc.BlockNumber, idx hexutil.Uint) (*rpc.EthTxJsonRPC, error)
-	// eth_getBlockReceipts
+	GetBlockReceipts(blockNrOrHash rpc.BlockNumberOrHash) ([]*backend.TransactionReceipt, error)
 
 	// Writing Transactions
 	//
@@ -74,8 +74,14 @@ type IEthAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(
-		args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash, _ *rpc.StateOverride,
+		args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *rpc.StateOverride,
 	) (hexutil.Bytes, error)
+	CreateAccessList(
+		args evm.JsonTxArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *rpc.StateOverride,
+	) (*rpc.AccessListResult, error)
+	SimulateV1(
+		opts evm.SimOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+	) ([]map[string]any, error)
 
 	// Chain Information
 	//
@@ -83,7 +89,7 @@ type IEthAPI interface {
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
 	EstimateGas(
-		args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+		args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber, overrides *rpc.StateOverride,
 	) (hexutil.Uint64, error)
 	FeeHistory(
 		blockCount gethrpc.DecimalOrHex, lastBlock gethrpc.BlockNumber, rewardPercentiles []float64,
@@ -186,6 +192,15 @@ func (e *EthAPI) GetTransactionReceipt(
 	return e.backend.GetTransactionReceipt(hash)
 }
 
+// GetBlockReceipts returns the receipts of all transactions in the block
+// identified by number or hash.
+func (e *EthAPI) GetBlockReceipts(
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]*backend.TransactionReceipt, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+	return e.backend.GetBlockReceipts(blockNrOrHash)
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
 func (e *EthAPI) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
 	e.logger.Debug("eth_getBlockTransactionCountByHash", "hash", hash.Hex())
@@ -273,10 +288,11 @@ func (e *EthAPI) GetProof(address common.Address,
 //                           EVM/Smart Contract Execution
 // --------------------------------------------------------------------------
 
-// Call performs a raw contract call.
+// Call performs a raw contract call. The optional state overrides are applied
+// to the queried state before the call is executed.
 func (e *EthAPI) Call(args evm.JsonTxArgs,
 	blockNrOrHash rpc.BlockNumberOrHash,
-	_ *rpc.StateOverride,
+	overrides *rpc.StateOverride,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
@@ -284,7 +300,7 @@ func (e *EthAPI) Call(args evm.JsonTxArgs,
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(args, blockNum, overrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -292,6 +308,47 @@ func (e *EthAPI) Call(args evm.JsonTxArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// CreateAccessList returns the EIP-2930 access list of the given call, the gas
+// used by the call with that access list and the vm error, if any. The block
+// defaults to "pending".
+func (e *EthAPI) CreateAccessList(
+	args evm.JsonTxArgs,
+	blockNrOrHash *rpc.BlockNumberOrHash,
+	overrides *rpc.StateOverride,
+) (*rpc.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum := rpc.EthPendingBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.CreateAccessList(args, blockNum, overrides)
+}
+
+// SimulateV1 runs ordered batches of calls in a sequence of simulated blocks on
+// top of the given block, with per-block header and state overrides, and
+// returns the simulated blocks with the results of their calls. No state is
+// committed. The block defaults to "latest".
+func (e *EthAPI) SimulateV1(
+	opts evm.SimOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+) ([]map[string]any, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	blockNum := rpc.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.SimulateV1(opts, blockNum)
+}
+
 // --------------------------------------------------------------------------
 //                           Event Logs
 // --------------------------------------------------------------------------
@@ -314,11 +371,12 @@ func (e *EthAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
+// The optional state overrides are applied before the estimate is made.
 func (e *EthAPI) EstimateGas(
-	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber, overrides *rpc.StateOverride,
 ) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides)
 }
 
 func (e *EthAPI) FeeHistory(blockCount gethrpc.DecimalOrHex,
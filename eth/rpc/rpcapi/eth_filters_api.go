@@ -4,13 +4,11 @@ package rpcapi
 import (
 	"context"
 	"fmt"
-	"math/big"
 	"sync"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 
-	"github.com/NibiruChain/nibiru/v2/eth/rpc"
 	rpcbackend "github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -31,12 +29,13 @@ import (
 // external clients to retrieve various information related to the Ethereum
 // protocol such as blocks, transactions and logs.
 type FiltersAPI struct {
-	logger    log.Logger
-	clientCtx client.Context
-	backend   *rpcbackend.Backend
-	events    *EventSubscriber
-	filtersMu sync.Mutex
-	filters   map[gethrpc.ID]*filter
+	logger      log.Logger
+	clientCtx   client.Context
+	backend     *rpcbackend.Backend
+	queryClient evm.QueryClient
+	events      *EventSubscriber
+	filtersMu   sync.Mutex
+	filters     map[gethrpc.ID]*filter
 }
 
 // consider a filter inactive if it has not been polled for within deadlineForInactivity
@@ -62,11 +61,12 @@ func NewImplFiltersAPI(
 ) *FiltersAPI {
 	logger = logger.With("api", "filter")
 	api := &FiltersAPI{
-		logger:    logger,
-		clientCtx: clientCtx,
-		backend:   backend,
-		filters:   make(map[gethrpc.ID]*filter),
-		events:    NewEventSubscriber(logger, tmWSClient),
+		logger:      logger,
+		clientCtx:   clientCtx,
+		backend:     backend,
+		queryClient: evm.NewQueryClient(clientCtx),
+		filters:     make(map[gethrpc.ID]*filter),
+		events:      NewEventSubscriber(logger, tmWSClient),
 	}
 
 	go api.timeoutLoop()
@@ -336,13 +336,11 @@ func (api *FiltersAPI) NewHeads(ctx context.Context) (*gethrpc.Subscription, err
 					continue
 				}
 
-				var baseFee *big.Int = nil
-				bloom, err := ParseBloomFromEvents(data.ResultEndBlock.Events)
+				header, err := NewHeadFromEvent(api.queryClient, data)
 				if err != nil {
 					api.logger.Error("failed to parse bloom from end block events")
 					return
 				}
-				header := rpc.EthHeaderFromTendermint(data.Header, bloom, baseFee)
 				_ = notifier.Notify(rpcSub.ID, header) // #nosec G703
 			case <-rpcSub.Err():
 				headersSub.Unsubscribe(api.events)
@@ -2,13 +2,20 @@ package rpcapi_test
 
 import (
 	"context"
+	"math/big"
 	"os"
+	"strconv"
 	"sync"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/cometbft/cometbft/libs/log"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -125,3 +132,41 @@ func (s *Suite) TestParseBloomFromEvents() {
 		})
 	}
 }
+
+// baseFeeQueryClient is an EVM query client that answers base fee queries with
+// the height of the query.
+type baseFeeQueryClient struct {
+	evm.QueryClient
+}
+
+func (c baseFeeQueryClient) BaseFee(
+	ctx context.Context, _ *evm.QueryBaseFeeRequest, _ ...grpc.CallOption,
+) (*evm.QueryBaseFeeResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	height, err := strconv.ParseInt(md.Get(grpctypes.GRPCBlockHeightHeader)[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	baseFee := sdkmath.NewInt(height)
+	return &evm.QueryBaseFeeResponse{BaseFee: &baseFee}, nil
+}
+
+func (s *Suite) TestNewHeadFromEvent() {
+	bloom := gethcore.Bloom{}
+	copy(bloom[:], []byte("dummybloom"))
+	deps := evmtest.NewTestDeps()
+	s.Require().NoError(deps.Ctx.EventManager().EmitTypedEvent(
+		&evm.EventBlockBloom{Bloom: eth.BloomToHex(bloom)},
+	))
+
+	data := tmtypes.EventDataNewBlockHeader{
+		Header:         tmtypes.Header{Height: 10},
+		ResultEndBlock: abci.ResponseEndBlock{Events: deps.Ctx.EventManager().ABCIEvents()},
+	}
+	header, err := rpcapi.NewHeadFromEvent(baseFeeQueryClient{}, data)
+	s.Require().NoError(err)
+	s.Equal(bloom, header.Bloom)
+	s.Equal(int64(10), header.Number.Int64())
+	// The base fee of the block is read from the state of its parent.
+	s.Equal(big.NewInt(9), header.BaseFee)
+}
@@ -6,12 +6,14 @@ import (
 
 	"cosmossdk.io/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
@@ -129,3 +131,28 @@ func ParseBloomFromEvents(events []abci.Event) (bloom gethcore.Bloom, err error)
 	}
 	return bloom, err
 }
+
+// NewHeadFromEvent converts a new block header event to the eth header sent to
+// "newHeads" subscribers, with the bloom emitted at the end of the block and
+// the base fee in effect for the block. The base fee of a block is set at the
+// end of its parent block, so it is queried from the state of the parent. The
+// base fee is nil if it can't be queried.
+func NewHeadFromEvent(
+	queryClient evm.QueryClient, data tmtypes.EventDataNewBlockHeader,
+) (*gethcore.Header, error) {
+	bloom, err := ParseBloomFromEvents(data.ResultEndBlock.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseFeeWei *big.Int
+	height := data.Header.Height
+	if height > 1 {
+		height--
+	}
+	res, err := queryClient.BaseFee(rpc.NewContextWithHeight(height), &evm.QueryBaseFeeRequest{})
+	if err == nil && res.BaseFee != nil {
+		baseFeeWei = res.BaseFee.BigInt()
+	}
+	return rpc.EthHeaderFromTendermint(data.Header, bloom, baseFeeWei), nil
+}
@@ -146,20 +146,41 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*get
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
-	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
+	from := f.criteria.FromBlock.Int64()
+	to := f.criteria.ToBlock.Int64()
+
+	// With the EVM tx indexer, the logs of the blocks it has seen are read from
+	// its log index, and only the blocks past the last indexed one are scanned.
+	// The block range cap only applies to the scanned blocks.
+	if indexer := f.backend.EVMTxIndexer(); indexer != nil {
+		first, last, err := indexer.IndexedBlockRange()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch indexed block range: %w", err)
+		}
+		if first >= 0 && first <= from && from <= last {
+			indexedTo := min(to, last)
+			logs, err = indexer.GetLogs(from, indexedTo, f.criteria.Addresses, f.criteria.Topics, logLimit)
+			if err != nil {
+				return nil, err
+			}
+			if indexedTo == to {
+				return logs, nil
+			}
+			from = indexedTo + 1
+		}
+	}
+
+	if to-from > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
 	// check bounds
-	if f.criteria.FromBlock.Int64() > head {
-		return []*gethcore.Log{}, nil
-	} else if f.criteria.ToBlock.Int64() > head+maxToOverhang {
-		f.criteria.ToBlock = big.NewInt(head + maxToOverhang)
+	if from > head {
+		return logs, nil
+	} else if to > head+maxToOverhang {
+		to = head + maxToOverhang
 	}
 
-	from := f.criteria.FromBlock.Int64()
-	to := f.criteria.ToBlock.Int64()
-
 	for height := from; height <= to; height++ {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
@@ -0,0 +1,129 @@
+package rpcapi_test
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi/otsapi"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testnetwork"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+// Test_OtsSearchTransactions: ots_searchTransactionsBefore and
+// ots_searchTransactionsAfter
+func (s *NodeSuite) Test_OtsSearchTransactions() {
+	otsAPI := otsapi.NewImplOtsAPI(s.val.Ctx, s.val.EthRpcBackend, s.val.EthTxIndexer)
+	chainID, err := s.ethClient.ChainID(context.Background())
+	s.Require().NoError(err)
+	signer := gethcore.LatestSignerForChainID(chainID)
+
+	s.T().Log("Fund a sender of its own, to leave the balance of the other tests as is")
+	sender := evmtest.NewEthPrivAcc()
+	senderKey, err := sender.PrivKey.ToECDSA()
+	s.Require().NoError(err)
+	_, err = testnetwork.FillWalletFromValidator(
+		sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(eth.EthBaseDenom, 1_000_000)),
+		s.val,
+		eth.EthBaseDenom,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	s.T().Log("Send 3 txs to a new address, each in its own block")
+	recipient := evmtest.NewEthPrivAcc().EthAddr
+	var txHashes []gethcommon.Hash
+	var txBlocks []uint64
+	for i := 0; i < 3; i++ {
+		nonce, err := s.ethClient.PendingNonceAt(context.Background(), sender.EthAddr)
+		s.Require().NoError(err)
+		tx, err := gethcore.SignNewTx(senderKey, signer, &gethcore.LegacyTx{
+			Nonce:    nonce,
+			To:       &recipient,
+			Value:    evm.NativeToWei(big.NewInt(1)),
+			Gas:      params.TxGas,
+			GasPrice: evm.NativeToWei(big.NewInt(1)),
+		})
+		s.Require().NoError(err)
+		s.Require().NoError(s.ethClient.SendTransaction(context.Background(), tx))
+		s.Require().NoError(s.network.WaitForNextBlock())
+
+		receipt, err := s.ethClient.TransactionReceipt(context.Background(), tx.Hash())
+		s.Require().NoError(err)
+		txHashes = append(txHashes, tx.Hash())
+		txBlocks = append(txBlocks, receipt.BlockNumber.Uint64())
+	}
+	s.Require().Less(txBlocks[0], txBlocks[1])
+	s.Require().Less(txBlocks[1], txBlocks[2])
+	for attempt := 0; ; attempt++ {
+		_, last, err := s.val.EthTxIndexer.IndexedBlockRange()
+		s.Require().NoError(err)
+		if last >= int64(txBlocks[2]) {
+			break
+		}
+		s.Require().Less(attempt, 5, "indexer did not catch up")
+		s.Require().NoError(s.network.WaitForNextBlock())
+	}
+
+	pageHashes := func(page *otsapi.TransactionsWithReceipts) (hashes []gethcommon.Hash) {
+		for _, tx := range page.Txs {
+			hashes = append(hashes, tx.Hash)
+		}
+		return hashes
+	}
+
+	s.T().Log("SearchTransactionsBefore pages from the newest tx")
+	{
+		page, err := otsAPI.SearchTransactionsBefore(recipient, 0, 2)
+		s.Require().NoError(err)
+		s.Equal([]gethcommon.Hash{txHashes[2], txHashes[1]}, pageHashes(page))
+		s.True(page.FirstPage)
+		s.False(page.LastPage)
+
+		page, err = otsAPI.SearchTransactionsBefore(recipient, txBlocks[1], 2)
+		s.Require().NoError(err)
+		s.Equal([]gethcommon.Hash{txHashes[0]}, pageHashes(page))
+		s.False(page.FirstPage)
+		s.True(page.LastPage)
+	}
+
+	s.T().Log("SearchTransactionsAfter pages from the oldest tx, newest first")
+	{
+		page, err := otsAPI.SearchTransactionsAfter(recipient, 0, 2)
+		s.Require().NoError(err)
+		s.Equal([]gethcommon.Hash{txHashes[1], txHashes[0]}, pageHashes(page))
+		s.False(page.FirstPage)
+		s.True(page.LastPage)
+		s.Len(page.Receipts, 2)
+
+		page, err = otsAPI.SearchTransactionsAfter(recipient, txBlocks[1], 2)
+		s.Require().NoError(err)
+		s.Equal([]gethcommon.Hash{txHashes[2]}, pageHashes(page))
+		s.True(page.FirstPage)
+		s.False(page.LastPage)
+	}
+
+	s.T().Log("The page size is between 1 and MaxPageSize")
+	for _, pageSize := range []uint16{0, otsapi.MaxPageSize + 1} {
+		_, err := otsAPI.SearchTransactionsBefore(recipient, 0, pageSize)
+		s.ErrorContains(err, "invalid page size")
+		_, err = otsAPI.SearchTransactionsAfter(recipient, 0, pageSize)
+		s.ErrorContains(err, "invalid page size")
+	}
+
+	s.T().Log("A single page holds the whole history")
+	{
+		page, err := otsAPI.SearchTransactionsAfter(recipient, 0, 10)
+		s.Require().NoError(err)
+		s.Equal([]gethcommon.Hash{txHashes[2], txHashes[1], txHashes[0]}, pageHashes(page))
+		s.True(page.FirstPage)
+		s.True(page.LastPage)
+	}
+}
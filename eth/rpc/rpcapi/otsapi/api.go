@@ -0,0 +1,272 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package otsapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
+)
+
+// ApiLevel is the version of the Otterscan API reported by "ots_getApiLevel".
+// Otterscan refuses to connect to nodes that report a lower level. Methods of
+// that level that aren't implemented by the "ots_" namespace fail with a
+// "method not found" error.
+const ApiLevel uint64 = 8
+
+// MaxPageSize is the largest "pageSize" of the "ots_searchTransactions*"
+// methods.
+const MaxPageSize = 1000
+
+// ErrIndexerDisabled is returned by the methods that need the address indexes
+// of the EVM tx indexer when the indexer is disabled.
+var ErrIndexerDisabled = errors.New(
+	"the ots_ namespace requires the EVM tx indexer: set \"json-rpc.enable-indexer\" to true",
+)
+
+// OtsAPI implements the Otterscan "ots_" namespace on top of the address
+// indexes of the EVM tx indexer.
+type OtsAPI struct {
+	logger  log.Logger
+	backend *backend.Backend
+	indexer eth.EVMTxIndexer
+}
+
+// NewImplOtsAPI creates a new API definition for the "ots_" namespace.
+func NewImplOtsAPI(
+	ctx *server.Context,
+	backend *backend.Backend,
+	indexer eth.EVMTxIndexer,
+) *OtsAPI {
+	return &OtsAPI{
+		logger:  ctx.Logger.With("module", "ots"),
+		backend: backend,
+		indexer: indexer,
+	}
+}
+
+// GetApiLevel returns the version of the Otterscan API implemented by the node.
+func (a *OtsAPI) GetApiLevel() uint64 {
+	a.logger.Debug("ots_getApiLevel")
+	return ApiLevel
+}
+
+// HasCode returns true if there is contract code at the address in the given
+// block.
+func (a *OtsAPI) HasCode(
+	addr common.Address, blockNrOrHash rpc.BlockNumberOrHash,
+) (bool, error) {
+	a.logger.Debug("ots_hasCode", "address", addr, "block number or hash", blockNrOrHash)
+	code, err := a.backend.GetCode(addr, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// SearchTransactionsBefore returns the txs of an address in the blocks before
+// "blockNum", from the newest to the oldest. A "blockNum" of 0 searches from
+// the latest block. A page holds about "pageSize" txs: the txs of a block are
+// never split across pages.
+//
+// The history only covers the blocks of the EVM tx indexer, see
+// [eth.EVMTxIndexer.GetTxHashesByAddress]: the last page ends at the first
+// indexed block.
+func (a *OtsAPI) SearchTransactionsBefore(
+	addr common.Address, blockNum uint64, pageSize uint16,
+) (*TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsBefore", "address", addr, "block", blockNum, "page size", pageSize)
+	if a.indexer == nil {
+		return nil, ErrIndexerDisabled
+	}
+	if err := validatePageSize(pageSize); err != nil {
+		return nil, err
+	}
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	isFirstPage := blockNum == 0
+	toBlock := int64(latest) // #nosec G701
+	if !isFirstPage {
+		toBlock = int64(blockNum) - 1 // #nosec G701
+	}
+	hashes, hasMore, err := a.indexer.GetTxHashesByAddress(addr, 0, toBlock, true, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	return a.txsWithReceipts(hashes, isFirstPage, !hasMore)
+}
+
+// SearchTransactionsAfter returns the txs of an address in the blocks after
+// "blockNum", from the newest to the oldest. A "blockNum" of 0 searches from
+// the first block of the EVM tx indexer. A page holds about "pageSize" txs:
+// the txs of a block are never split across pages.
+func (a *OtsAPI) SearchTransactionsAfter(
+	addr common.Address, blockNum uint64, pageSize uint16,
+) (*TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsAfter", "address", addr, "block", blockNum, "page size", pageSize)
+	if a.indexer == nil {
+		return nil, ErrIndexerDisabled
+	}
+	if err := validatePageSize(pageSize); err != nil {
+		return nil, err
+	}
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	isLastPage := blockNum == 0
+	fromBlock := int64(0)
+	if !isLastPage {
+		fromBlock = int64(blockNum) + 1 // #nosec G701
+	}
+	hashes, hasMore, err := a.indexer.GetTxHashesByAddress(
+		addr, fromBlock, int64(latest), false, int(pageSize), // #nosec G701
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Pages are always ordered from the newest to the oldest tx.
+	for i, j := 0, len(hashes)-1; i < j; i, j = i+1, j-1 {
+		hashes[i], hashes[j] = hashes[j], hashes[i]
+	}
+	return a.txsWithReceipts(hashes, !hasMore, isLastPage)
+}
+
+// validatePageSize checks that the page size of a search is between 1 and
+// [MaxPageSize].
+func validatePageSize(pageSize uint16) error {
+	if pageSize == 0 || pageSize > MaxPageSize {
+		return fmt.Errorf("invalid page size %d: must be between 1 and %d", pageSize, MaxPageSize)
+	}
+	return nil
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the tx sent by "addr"
+// with the given nonce. If the tx is not found, this resolves to nil.
+func (a *OtsAPI) GetTransactionBySenderAndNonce(
+	addr common.Address, nonce hexutil.Uint64,
+) (*common.Hash, error) {
+	a.logger.Debug("ots_getTransactionBySenderAndNonce", "address", addr, "nonce", nonce)
+	if a.indexer == nil {
+		return nil, ErrIndexerDisabled
+	}
+	return a.indexer.GetTxHashBySenderAndNonce(addr, uint64(nonce))
+}
+
+// GetContractCreator returns the hash of the tx that deployed the contract at
+// "addr" and the sender of that tx. Only contracts deployed by a contract
+// creation tx are found. Otherwise, this resolves to nil.
+//
+// If the EVM tx indexer doesn't start at the first block, the contract may
+// have been deployed before it. In that case, this returns an error for an
+// address with code whose creator is not found instead.
+func (a *OtsAPI) GetContractCreator(addr common.Address) (*ContractCreatorData, error) {
+	a.logger.Debug("ots_getContractCreator", "address", addr)
+	if a.indexer == nil {
+		return nil, ErrIndexerDisabled
+	}
+	txHash, err := a.indexer.GetContractCreationTxHash(addr)
+	if err != nil {
+		return nil, err
+	}
+	if txHash == nil {
+		first, _, err := a.indexer.IndexedBlockRange()
+		if err != nil {
+			return nil, err
+		}
+		if first <= 1 {
+			return nil, nil
+		}
+		latest := rpc.EthLatestBlockNumber
+		hasCode, err := a.HasCode(addr, rpc.BlockNumberOrHash{BlockNumber: &latest})
+		if err != nil {
+			return nil, err
+		}
+		if hasCode {
+			return nil, fmt.Errorf(
+				"contract creator not found in the blocks indexed from %d: "+
+					"set \"json-rpc.indexer-start-height\" to index older blocks",
+				first,
+			)
+		}
+		return nil, nil
+	}
+	tx, err := a.backend.GetTransactionByHash(*txHash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("contract creation tx %s not found", txHash.Hex())
+	}
+	return &ContractCreatorData{
+		Hash:    *txHash,
+		Creator: tx.From,
+	}, nil
+}
+
+// txsWithReceipts loads the txs and receipts of a page of tx hashes. The
+// timestamp of each block is added to the receipts of its txs.
+func (a *OtsAPI) txsWithReceipts(
+	hashes []common.Hash, firstPage, lastPage bool,
+) (*TransactionsWithReceipts, error) {
+	res := &TransactionsWithReceipts{
+		Txs:       []*rpc.EthTxJsonRPC{},
+		Receipts:  []map[string]any{},
+		FirstPage: firstPage,
+		LastPage:  lastPage,
+	}
+	blockTimes := make(map[int64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := a.backend.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := a.backend.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || receipt == nil {
+			return nil, fmt.Errorf("indexed tx %s not found", hash.Hex())
+		}
+
+		height := receipt.BlockNumber.Int64()
+		blockTime, ok := blockTimes[height]
+		if !ok {
+			resBlock, err := a.backend.TendermintBlockByNumber(rpc.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			if resBlock == nil || resBlock.Block == nil {
+				return nil, fmt.Errorf("block %d not found", height)
+			}
+			blockTime = hexutil.Uint64(resBlock.Block.Time.Unix()) // #nosec G701
+			blockTimes[height] = blockTime
+		}
+
+		receiptJson, err := json.Marshal(receipt)
+		if err != nil {
+			return nil, err
+		}
+		var receiptMap map[string]any
+		if err := json.Unmarshal(receiptJson, &receiptMap); err != nil {
+			return nil, err
+		}
+		receiptMap["timestamp"] = blockTime
+
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receiptMap)
+	}
+	return res, nil
+}
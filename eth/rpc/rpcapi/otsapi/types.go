@@ -0,0 +1,28 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package otsapi
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+)
+
+// TransactionsWithReceipts is a page of the transaction history of an address,
+// returned by "ots_searchTransactionsBefore" and "ots_searchTransactionsAfter".
+// Txs and Receipts are ordered from the newest to the oldest transaction.
+type TransactionsWithReceipts struct {
+	Txs []*rpc.EthTxJsonRPC `json:"txs"`
+	// Receipts are the JSON receipts of the txs with an extra "timestamp" field
+	// holding the time of the block.
+	Receipts []map[string]any `json:"receipts"`
+	// FirstPage is true if there are no newer txs.
+	FirstPage bool `json:"firstPage"`
+	// LastPage is true if there are no older txs.
+	LastPage bool `json:"lastPage"`
+}
+
+// ContractCreatorData is the result of "ots_getContractCreator".
+type ContractCreatorData struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
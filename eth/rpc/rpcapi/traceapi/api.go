@@ -0,0 +1,246 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package traceapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// callTracer is the native go-ethereum tracer the traces are built from.
+const callTracer = "callTracer"
+
+// TraceAPI implements the Parity/OpenEthereum "trace_" namespace. Its traces
+// are the flattened call frames of the native "callTracer".
+type TraceAPI struct {
+	logger  log.Logger
+	backend *backend.Backend
+}
+
+// NewImplTraceAPI creates a new API definition for the "trace_" namespace.
+func NewImplTraceAPI(
+	ctx *server.Context,
+	backend *backend.Backend,
+) *TraceAPI {
+	return &TraceAPI{
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Transaction returns the traces of the transaction with the given hash. If
+// the transaction is not found, this resolves to nil.
+func (a *TraceAPI) Transaction(hash common.Hash) ([]*LocalizedTrace, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	tx, err := a.backend.GetTransactionByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil || tx.BlockHash == nil {
+		// not found or still pending
+		return nil, nil
+	}
+
+	result, err := a.backend.TraceTransaction(hash, &evm.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+	return flattenTraceResult(result, txLocation{
+		BlockHash:   *tx.BlockHash,
+		BlockNumber: tx.BlockNumber.ToInt().Uint64(),
+		TxHash:      hash,
+		TxPosition:  uint64(*tx.TransactionIndex),
+	})
+}
+
+// Block returns the traces of all transactions in the given block.
+func (a *TraceAPI) Block(blockNum rpc.BlockNumber) ([]*LocalizedTrace, error) {
+	a.logger.Debug("trace_block", "number", blockNum)
+	if blockNum == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		a.logger.Debug("get block failed", "height", blockNum, "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+	return a.traceBlock(resBlock)
+}
+
+// Filter returns the traces of the blocks in [FromBlock, ToBlock] whose
+// sender and recipient match the given addresses. The block range can't be
+// wider than the "block-range-cap" of the JSON-RPC config.
+func (a *TraceAPI) Filter(args FilterArgs) ([]*LocalizedTrace, error) {
+	a.logger.Debug("trace_filter", "args", args)
+
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	resolve := func(bn *rpc.BlockNumber) uint64 {
+		if bn == nil || *bn < 0 {
+			// "latest" and "pending" both resolve to the latest block
+			return uint64(latest)
+		}
+		return uint64(bn.Int64())
+	}
+	from, to := resolve(args.FromBlock), resolve(args.ToBlock)
+	if from == 0 {
+		// genesis is not traceable
+		from = 1
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: fromBlock %d is greater than toBlock %d", from, to)
+	}
+	blockLimit := uint64(a.backend.RPCBlockRangeCap()) // #nosec G701
+	if to-from+1 > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	fromAddrs := addressSet(args.FromAddress)
+	toAddrs := addressSet(args.ToAddress)
+
+	var (
+		matches []*LocalizedTrace
+		skipped uint64
+	)
+	for height := from; height <= to; height++ {
+		traces, err := a.Block(rpc.BlockNumber(height)) // #nosec G701
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range traces {
+			if !traceMatches(trace, fromAddrs, toAddrs) {
+				continue
+			}
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+			matches = append(matches, trace)
+			if args.Count != nil && uint64(len(matches)) >= *args.Count {
+				return matches, nil
+			}
+		}
+	}
+	if matches == nil {
+		matches = []*LocalizedTrace{}
+	}
+	return matches, nil
+}
+
+// traceBlock traces all Ethereum txs of the block with "callTracer". Txs that
+// were not executed, for example because they failed the ante handler, are
+// skipped.
+func (a *TraceAPI) traceBlock(resBlock *tmrpctypes.ResultBlock) ([]*LocalizedTrace, error) {
+	height := resBlock.Block.Height
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return nil, err
+	}
+	txResults, err := a.backend.TraceBlock(
+		rpc.BlockNumber(height), &evm.TraceConfig{Tracer: callTracer}, resBlock,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// TraceBlock traces every MsgEthereumTx in the block, in order, while the
+	// tx positions only count the txs that were executed.
+	executedMsgs := a.backend.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	tracedMsgs := a.backend.TraceableEthMsgs(resBlock)
+
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash)
+	traces := []*LocalizedTrace{}
+	txPosition := 0
+	for i, txResult := range txResults {
+		if i >= len(tracedMsgs) || txPosition >= len(executedMsgs) {
+			break
+		}
+		txHash := tracedMsgs[i].AsTransaction().Hash()
+		if txHash.Hex() != executedMsgs[txPosition].Hash {
+			continue
+		}
+		if txResult.Error != "" {
+			return nil, fmt.Errorf("failed to trace tx %s: %s", txHash.Hex(), txResult.Error)
+		}
+		txTraces, err := flattenTraceResult(txResult.Result, txLocation{
+			BlockHash:   blockHash,
+			BlockNumber: uint64(height), // #nosec G701
+			TxHash:      txHash,
+			TxPosition:  uint64(txPosition), // #nosec G701
+		})
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+		txPosition++
+	}
+	return traces, nil
+}
+
+// flattenTraceResult decodes a "callTracer" result and flattens it.
+func flattenTraceResult(result any, loc txLocation) ([]*LocalizedTrace, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var frame callFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, fmt.Errorf("failed to decode call frame: %w", err)
+	}
+	return flattenCallFrame(frame, loc)
+}
+
+// traceMatches returns true if the sender of the trace is in fromAddrs and the
+// recipient is in toAddrs. For creates, the recipient is the created contract.
+// For suicides, the sender is the destroyed contract and the recipient is the
+// refund address. An empty set matches any address.
+func traceMatches(
+	trace *LocalizedTrace, fromAddrs, toAddrs map[common.Address]struct{},
+) bool {
+	var from, to *common.Address
+	switch trace.Type {
+	case TraceTypeCreate:
+		from = trace.Action.From
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	case TraceTypeSuicide:
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	default:
+		from, to = trace.Action.From, trace.Action.To
+	}
+	return addressMatches(from, fromAddrs) && addressMatches(to, toAddrs)
+}
+
+func addressMatches(addr *common.Address, set map[common.Address]struct{}) bool {
+	if len(set) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	_, ok := set[*addr]
+	return ok
+}
+
+func addressSet(addrs []common.Address) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addrs))
+	for _, addr := range addrs {
+		set[addr] = struct{}{}
+	}
+	return set
+}
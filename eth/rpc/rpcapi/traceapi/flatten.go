@@ -0,0 +1,164 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package traceapi
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// txLocation locates a transaction in the chain. It's copied into every trace
+// of the transaction.
+type txLocation struct {
+	BlockHash   common.Hash
+	BlockNumber uint64
+	TxHash      common.Hash
+	TxPosition  uint64
+}
+
+// flattenCallFrame converts the nested call frames of a "callTracer" result
+// into a list of traces in depth-first order. Each trace records its position
+// in the call tree as its "traceAddress".
+func flattenCallFrame(frame callFrame, loc txLocation) ([]*LocalizedTrace, error) {
+	traces := []*LocalizedTrace{}
+	if err := appendCallFrame(&traces, frame, []int{}, loc); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+func appendCallFrame(
+	traces *[]*LocalizedTrace, frame callFrame, traceAddress []int, loc txLocation,
+) error {
+	trace, err := newLocalizedTrace(frame, traceAddress, loc)
+	if err != nil {
+		return err
+	}
+	*traces = append(*traces, trace)
+	for i, child := range frame.Calls {
+		childAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		if err := appendCallFrame(traces, child, append(childAddress, i), loc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newLocalizedTrace converts a single call frame, without its children.
+func newLocalizedTrace(
+	frame callFrame, traceAddress []int, loc txLocation,
+) (*LocalizedTrace, error) {
+	gas, err := decodeUint64(frame.Gas)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas in call frame: %w", err)
+	}
+	gasUsed, err := decodeUint64(frame.GasUsed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gasUsed in call frame: %w", err)
+	}
+	value, err := decodeBig(frame.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value in call frame: %w", err)
+	}
+	input, err := decodeBytes(frame.Input)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input in call frame: %w", err)
+	}
+	output, err := decodeBytes(frame.Output)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output in call frame: %w", err)
+	}
+	from := common.HexToAddress(frame.From)
+	to := common.HexToAddress(frame.To)
+
+	trace := &LocalizedTrace{
+		BlockHash:           loc.BlockHash,
+		BlockNumber:         loc.BlockNumber,
+		Subtraces:           len(frame.Calls),
+		TraceAddress:        traceAddress,
+		TransactionHash:     loc.TxHash,
+		TransactionPosition: loc.TxPosition,
+	}
+	switch frame.Type {
+	case vm.CREATE.String(), vm.CREATE2.String():
+		trace.Type = TraceTypeCreate
+		trace.Action = Action{
+			From:  &from,
+			Gas:   &gas,
+			Init:  &input,
+			Value: value,
+		}
+		trace.Result = &Result{
+			GasUsed: gasUsed,
+			Address: &to,
+			Code:    &output,
+		}
+	case vm.SELFDESTRUCT.String():
+		trace.Type = TraceTypeSuicide
+		trace.Action = Action{
+			Address:       &from,
+			RefundAddress: &to,
+			Balance:       value,
+		}
+	default:
+		trace.Type = TraceTypeCall
+		trace.Action = Action{
+			CallType: strings.ToLower(frame.Type),
+			From:     &from,
+			To:       &to,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    value,
+		}
+		trace.Result = &Result{
+			GasUsed: gasUsed,
+			Output:  &output,
+		}
+	}
+
+	if frame.Error != "" {
+		trace.Error = parityError(frame.Error)
+		trace.Result = nil
+	}
+	return trace, nil
+}
+
+// parityError returns the Parity/OpenEthereum name of common EVM errors.
+func parityError(errMsg string) string {
+	switch errMsg {
+	case vm.ErrExecutionReverted.Error():
+		return "Reverted"
+	case vm.ErrOutOfGas.Error():
+		return "Out of gas"
+	default:
+		return errMsg
+	}
+}
+
+func decodeUint64(s string) (hexutil.Uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	n, err := hexutil.DecodeUint64(s)
+	return hexutil.Uint64(n), err
+}
+
+func decodeBig(s string) (*hexutil.Big, error) {
+	if s == "" {
+		return (*hexutil.Big)(big.NewInt(0)), nil
+	}
+	n, err := hexutil.DecodeBig(s)
+	return (*hexutil.Big)(n), err
+}
+
+func decodeBytes(s string) (hexutil.Bytes, error) {
+	if s == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return hexutil.Decode(s)
+}
@@ -0,0 +1,162 @@
+package traceapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// callTracerResult is a "callTracer" result of a call that creates a contract,
+// delegates a call to it that reverts, and self-destructs.
+const callTracerResult = `{
+  "type": "CALL",
+  "from": "0x1000000000000000000000000000000000000001",
+  "to": "0x2000000000000000000000000000000000000002",
+  "value": "0x64",
+  "gas": "0x7530",
+  "gasUsed": "0x5208",
+  "input": "0xabcdef",
+  "output": "0x01",
+  "calls": [
+    {
+      "type": "CREATE2",
+      "from": "0x2000000000000000000000000000000000000002",
+      "to": "0x3000000000000000000000000000000000000003",
+      "value": "0x0",
+      "gas": "0x1000",
+      "gasUsed": "0x800",
+      "input": "0x6080",
+      "output": "0x60aa",
+      "calls": [
+        {
+          "type": "DELEGATECALL",
+          "from": "0x3000000000000000000000000000000000000003",
+          "to": "0x4000000000000000000000000000000000000004",
+          "gas": "0x100",
+          "gasUsed": "0x100",
+          "input": "0x",
+          "error": "execution reverted"
+        }
+      ]
+    },
+    {
+      "type": "SELFDESTRUCT",
+      "from": "0x2000000000000000000000000000000000000002",
+      "to": "0x5000000000000000000000000000000000000005",
+      "value": "0x64",
+      "gas": "0x0",
+      "gasUsed": "0x0",
+      "input": "0x"
+    }
+  ]
+}`
+
+func TestFlattenCallFrame(t *testing.T) {
+	var frame callFrame
+	require.NoError(t, json.Unmarshal([]byte(callTracerResult), &frame))
+
+	loc := txLocation{
+		BlockHash:   common.HexToHash("0xb10c"),
+		BlockNumber: 42,
+		TxHash:      common.HexToHash("0x7e"),
+		TxPosition:  3,
+	}
+	traces, err := flattenCallFrame(frame, loc)
+	require.NoError(t, err)
+	require.Len(t, traces, 4)
+
+	for _, trace := range traces {
+		require.Equal(t, loc.BlockHash, trace.BlockHash)
+		require.Equal(t, loc.BlockNumber, trace.BlockNumber)
+		require.Equal(t, loc.TxHash, trace.TransactionHash)
+		require.Equal(t, loc.TxPosition, trace.TransactionPosition)
+	}
+
+	// Top-level call
+	top := traces[0]
+	require.Equal(t, TraceTypeCall, top.Type)
+	require.Equal(t, "call", top.Action.CallType)
+	require.Equal(t, common.HexToAddress("0x1000000000000000000000000000000000000001"), *top.Action.From)
+	require.Equal(t, common.HexToAddress("0x2000000000000000000000000000000000000002"), *top.Action.To)
+	require.EqualValues(t, 30_000, *top.Action.Gas)
+	require.EqualValues(t, 100, top.Action.Value.ToInt().Int64())
+	require.Equal(t, "0xabcdef", top.Action.Input.String())
+	require.EqualValues(t, 21_000, top.Result.GasUsed)
+	require.Equal(t, "0x01", top.Result.Output.String())
+	require.Equal(t, 2, top.Subtraces)
+	require.Equal(t, []int{}, top.TraceAddress)
+
+	// Contract creation
+	create := traces[1]
+	require.Equal(t, TraceTypeCreate, create.Type)
+	require.Equal(t, "0x6080", create.Action.Init.String())
+	require.Nil(t, create.Action.To)
+	require.Equal(t, common.HexToAddress("0x3000000000000000000000000000000000000003"), *create.Result.Address)
+	require.Equal(t, "0x60aa", create.Result.Code.String())
+	require.Equal(t, 1, create.Subtraces)
+	require.Equal(t, []int{0}, create.TraceAddress)
+
+	// Reverted delegate call
+	delegateCall := traces[2]
+	require.Equal(t, TraceTypeCall, delegateCall.Type)
+	require.Equal(t, "delegatecall", delegateCall.Action.CallType)
+	require.Equal(t, "Reverted", delegateCall.Error)
+	require.Nil(t, delegateCall.Result)
+	require.EqualValues(t, 0, delegateCall.Action.Value.ToInt().Int64())
+	require.Equal(t, []int{0, 0}, delegateCall.TraceAddress)
+
+	// Self-destruct
+	suicide := traces[3]
+	require.Equal(t, TraceTypeSuicide, suicide.Type)
+	require.Equal(t, common.HexToAddress("0x2000000000000000000000000000000000000002"), *suicide.Action.Address)
+	require.Equal(t, common.HexToAddress("0x5000000000000000000000000000000000000005"), *suicide.Action.RefundAddress)
+	require.EqualValues(t, 100, suicide.Action.Balance.ToInt().Int64())
+	require.Nil(t, suicide.Result)
+	require.Equal(t, []int{1}, suicide.TraceAddress)
+
+	// JSON shape
+	bz, err := json.Marshal(top)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"traceAddress":[]`)
+	require.Contains(t, string(bz), `"blockNumber":42`)
+	require.Contains(t, string(bz), `"callType":"call"`)
+}
+
+func TestTraceMatches(t *testing.T) {
+	var frame callFrame
+	require.NoError(t, json.Unmarshal([]byte(callTracerResult), &frame))
+	traces, err := flattenCallFrame(frame, txLocation{})
+	require.NoError(t, err)
+
+	addr := func(hex string) []common.Address {
+		return []common.Address{common.HexToAddress(hex)}
+	}
+	matching := func(fromAddrs, toAddrs []common.Address) (traceAddrs [][]int) {
+		for _, trace := range traces {
+			if traceMatches(trace, addressSet(fromAddrs), addressSet(toAddrs)) {
+				traceAddrs = append(traceAddrs, trace.TraceAddress)
+			}
+		}
+		return traceAddrs
+	}
+
+	require.Len(t, matching(nil, nil), 4)
+	require.Equal(t, [][]int{{}}, matching(addr("0x1000000000000000000000000000000000000001"), nil))
+	require.Equal(t,
+		[][]int{{0}, {1}},
+		matching(addr("0x2000000000000000000000000000000000000002"), nil),
+	)
+	// The recipient of a create is the created contract.
+	require.Equal(t, [][]int{{0}}, matching(nil, addr("0x3000000000000000000000000000000000000003")))
+	// The recipient of a suicide is the refund address.
+	require.Equal(t,
+		[][]int{{1}},
+		matching(
+			addr("0x2000000000000000000000000000000000000002"),
+			addr("0x5000000000000000000000000000000000000005"),
+		),
+	)
+	require.Empty(t, matching(addr("0x5000000000000000000000000000000000000005"), nil))
+}
@@ -2,53 +2,123 @@
 package rpcapi
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
 )
 
 // TxPoolAPI offers and API for the transaction pool. It only operates on data
 // that is non-confidential.
 type TxPoolAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend *backend.Backend
 }
 
 // NewImplTxPoolAPI creates a new tx pool service that gives information about the transaction pool.
-func NewImplTxPoolAPI(logger log.Logger) *TxPoolAPI {
+func NewImplTxPoolAPI(logger log.Logger, backend *backend.Backend) *TxPoolAPI {
 	return &TxPoolAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
-// Content returns the transactions contained within the transaction pool
+// Content returns the transactions contained within the transaction pool,
+// grouped by status ("pending" or "queued"), sender and nonce.
 func (api *TxPoolAPI) Content() (
 	map[string]map[string]map[string]*rpc.EthTxJsonRPC, error,
 ) {
 	api.logger.Debug("txpool_content")
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
 	content := map[string]map[string]map[string]*rpc.EthTxJsonRPC{
 		"pending": make(map[string]map[string]*rpc.EthTxJsonRPC),
 		"queued":  make(map[string]map[string]*rpc.EthTxJsonRPC),
 	}
+	for status, txsBySender := range map[string]map[gethcommon.Address]map[uint64]*rpc.EthTxJsonRPC{
+		"pending": pool.Pending,
+		"queued":  pool.Queued,
+	} {
+		for sender, txsByNonce := range txsBySender {
+			dump := make(map[string]*rpc.EthTxJsonRPC, len(txsByNonce))
+			for nonce, tx := range txsByNonce {
+				dump[fmt.Sprintf("%d", nonce)] = tx
+			}
+			content[status][sender.Hex()] = dump
+		}
+	}
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list of summaries, one per transaction.
 func (api *TxPoolAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for status, txsBySender := range map[string]map[gethcommon.Address]map[uint64]*rpc.EthTxJsonRPC{
+		"pending": pool.Pending,
+		"queued":  pool.Queued,
+	} {
+		for sender, txsByNonce := range txsBySender {
+			dump := make(map[string]string, len(txsByNonce))
+			for nonce, tx := range txsByNonce {
+				dump[fmt.Sprintf("%d", nonce)] = formatTxSummary(tx)
+			}
+			content[status][sender.Hex()] = dump
+		}
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *TxPoolAPI) Status() map[string]hexutil.Uint {
+func (api *TxPoolAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(countTxs(pool.Pending)),
+		"queued":  hexutil.Uint(countTxs(pool.Queued)),
+	}, nil
+}
+
+// formatTxSummary returns the one-line summary of a tx used by
+// "txpool_inspect", in the same format as geth.
+func formatTxSummary(tx *rpc.EthTxJsonRPC) string {
+	gasPrice := tx.GasPrice
+	if tx.GasFeeCap != nil {
+		gasPrice = tx.GasFeeCap
+	}
+	if tx.To == nil {
+		return fmt.Sprintf(
+			"contract creation: %v wei + %v gas × %v wei",
+			tx.Value.ToInt(), uint64(tx.Gas), gasPrice.ToInt(),
+		)
+	}
+	return fmt.Sprintf(
+		"%s: %v wei + %v gas × %v wei",
+		tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), gasPrice.ToInt(),
+	)
+}
+
+func countTxs(txsBySender map[gethcommon.Address]map[uint64]*rpc.EthTxJsonRPC) (count int) {
+	for _, txsByNonce := range txsBySender {
+		count += len(txsByNonce)
 	}
+	return count
 }
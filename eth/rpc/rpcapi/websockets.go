@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -19,9 +20,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
-	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
-	"github.com/ethereum/go-ethereum/params"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -347,18 +346,20 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
-	events    *EventSubscriber
-	logger    log.Logger
-	clientCtx client.Context
+	events      *EventSubscriber
+	logger      log.Logger
+	clientCtx   client.Context
+	queryClient evm.QueryClient
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
 func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
-		events:    NewEventSubscriber(logger, tmWSClient),
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:      NewEventSubscriber(logger, tmWSClient),
+		logger:      logger,
+		clientCtx:   clientCtx,
+		queryClient: evm.NewQueryClient(clientCtx),
 	}
 }
 
@@ -392,9 +393,6 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID gethrpc.ID) (pubsu
 		return nil, errors.Wrap(err, "error creating block filter")
 	}
 
-	// TODO: use events
-	baseFeeWeiPerGas := big.NewInt(params.InitialBaseFee)
-
 	go func() {
 		headersCh := sub.EventCh
 		errCh := sub.Error()
@@ -411,7 +409,11 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID gethrpc.ID) (pubsu
 					continue
 				}
 
-				header := rpc.EthHeaderFromTendermint(data.Header, gethcore.Bloom{}, baseFeeWeiPerGas)
+				header, err := NewHeadFromEvent(api.queryClient, data)
+				if err != nil {
+					api.logger.Error("failed to parse bloom from end block events", "error", err.Error())
+					continue
+				}
 
 				// write to ws conn
 				res := &SubscriptionNotification{
@@ -685,8 +687,88 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID gethrpc
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ gethrpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// syncingPollInterval is how often a "syncing" subscription polls the sync
+// status of the node.
+const syncingPollInterval = 2 * time.Second
+
+// SyncingResult is the notification of a "syncing" subscription while the node
+// is catching up with the network. Once the node is in sync, the notification
+// is "false" instead.
+type SyncingResult struct {
+	Syncing bool           `json:"syncing"`
+	Status  map[string]any `json:"status"`
+}
+
+// subscribeSyncing polls the CometBFT sync info of the node and notifies the
+// subscriber of its current sync status, then of every change between catching
+// up and being in sync.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID gethrpc.ID) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a CometBFT RPC client")
+	}
+	status, err := api.clientCtx.Client.Status(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "error querying the node status")
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	unsubFn := func() { once.Do(func() { close(done) }) }
+
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
+
+		// Start from the opposite status so that the current one is sent.
+		catchingUp := !status.SyncInfo.CatchingUp
+		for {
+			if status != nil && status.SyncInfo.CatchingUp != catchingUp {
+				catchingUp = status.SyncInfo.CatchingUp
+
+				var result any = false
+				if catchingUp {
+					result = &SyncingResult{
+						Syncing: true,
+						Status:  rpc.SyncProgressFromTendermint(status.SyncInfo),
+					}
+				}
+
+				// write to ws conn
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       result,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Error("error writing sync status, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close() // #nosec G703
+						}
+					}, api.logger, "closing websocket peer sub")
+					return
+				}
+			}
+
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			status, err = api.clientCtx.Client.Status(context.Background())
+			if err != nil {
+				api.logger.Debug("failed to query the node status", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
+
+	return unsubFn, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
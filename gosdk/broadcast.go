@@ -2,8 +2,11 @@ package gosdk
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	cmtrpc "github.com/cometbft/cometbft/rpc/client"
+	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	sdkclienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -11,11 +14,11 @@ import (
 	sdktypestx "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc"
-
-	"github.com/NibiruChain/nibiru/v2/x/common"
-	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 )
 
+// BroadcastMsgsWithSeq signs a tx with the given msgs and account sequence and
+// broadcasts it. Unless the [TxOptions] of the args set a gas limit, the tx is
+// simulated first to get its gas limit.
 func BroadcastMsgsWithSeq(
 	args BroadcastArgs,
 	from sdk.AccAddress,
@@ -29,30 +32,27 @@ func BroadcastMsgsWithSeq(
 		return nil, err
 	}
 
-	txBuilder := args.txCfg.NewTxBuilder()
-	err = txBuilder.SetMsgs(msgs...)
+	nums, err := args.gosdk.GetAccountNumbers(from.String())
 	if err != nil {
 		return nil, err
 	}
 
-	bondDenom := denoms.NIBI
-	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1000))))
-	txBuilder.SetGasLimit(uint64(2 * common.TO_MICRO))
+	gasLimit := args.TxOptions.GasLimit
+	if gasLimit == 0 {
+		_, gasLimit, err = SimulateMsgs(args, from, nums.Number, seq, msgs...)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	nums, err := args.gosdk.GetAccountNumbers(from.String())
+	txFactory := args.txFactory(nums.Number, seq).
+		WithGas(gasLimit).
+		WithFees(args.TxOptions.Fee(gasLimit).String())
+	txBuilder, err := txFactory.BuildUnsignedTx(msgs...)
 	if err != nil {
 		return nil, err
 	}
 
-	var accRetriever sdkclient.AccountRetriever = authtypes.AccountRetriever{}
-	txFactory := sdkclienttx.Factory{}.
-		WithChainID(args.chainID).
-		WithKeybase(args.kring).
-		WithTxConfig(args.txCfg).
-		WithAccountRetriever(accRetriever).
-		WithAccountNumber(nums.Number).
-		WithSequence(seq)
-
 	overwriteSig := true
 	err = sdkclienttx.Sign(txFactory, info.Name, txBuilder, overwriteSig)
 	if err != nil {
@@ -141,7 +141,9 @@ type BroadcastArgs struct {
 	gosdk NibiruSDK
 	// clientCtx   sdkclient.Context // TODO: implement
 	Broadcaster Broadcaster
+	TxOptions   TxOptions
 	rpc         cmtrpc.Client
+	grpc        *grpc.ClientConn
 	chainID     string
 }
 
@@ -154,18 +156,45 @@ func initBroadcastArgs(
 		txCfg:       txConfig,
 		gosdk:       *nc,
 		Broadcaster: broadcaster,
+		TxOptions:   nc.TxOptions,
 		rpc:         nc.CometRPC,
+		grpc:        nc.Querier.ClientConn,
 		chainID:     nc.ChainId,
 	}
 }
 
+// txFactory returns a tx factory for the signer with the given account number
+// and sequence, with the fee granter and gas adjustment of the [TxOptions].
+func (args BroadcastArgs) txFactory(accNum, seq uint64) sdkclienttx.Factory {
+	gasAdjustment := args.TxOptions.GasAdjustment
+	if gasAdjustment <= 0 {
+		gasAdjustment = 1
+	}
+	var accRetriever sdkclient.AccountRetriever = authtypes.AccountRetriever{}
+	return sdkclienttx.Factory{}.
+		WithChainID(args.chainID).
+		WithKeybase(args.kring).
+		WithTxConfig(args.txCfg).
+		WithAccountRetriever(accRetriever).
+		WithAccountNumber(accNum).
+		WithSequence(seq).
+		WithGasAdjustment(gasAdjustment).
+		WithFeeGranter(args.TxOptions.FeeGranter)
+}
+
+// BroadcastMsgs broadcasts a tx with the given msgs over the CometBFT RPC. The
+// sequence of the tx comes from the sequence manager of the SDK, so txs from
+// the same signer can be broadcast concurrently.
 func (nc *NibiruSDK) BroadcastMsgs(
 	from sdk.AccAddress,
 	msgs ...sdk.Msg,
 ) (*sdk.TxResponse, error) {
 	broadcaster := BroadcasterTmRpc{RPC: nc.CometRPC}
 	args := initBroadcastArgs(nc, broadcaster)
-	return BroadcastMsgs(args, from, msgs...)
+	if nc.Sequences == nil {
+		return BroadcastMsgs(args, from, msgs...)
+	}
+	return broadcastWithSeqManager(args, from, msgs...)
 }
 
 func (nc *NibiruSDK) BroadcastMsgsWithSeq(
@@ -178,13 +207,18 @@ func (nc *NibiruSDK) BroadcastMsgsWithSeq(
 	return BroadcastMsgsWithSeq(args, from, seq, msgs...)
 }
 
+// BroadcastMsgsGrpc is the same as [NibiruSDK.BroadcastMsgs], except it
+// broadcasts the tx over gRPC.
 func (nc *NibiruSDK) BroadcastMsgsGrpc(
 	from sdk.AccAddress,
 	msgs ...sdk.Msg,
 ) (*sdk.TxResponse, error) {
 	broadcaster := BroadcasterGrpc{GRPC: nc.Querier.ClientConn}
 	args := initBroadcastArgs(nc, broadcaster)
-	return BroadcastMsgs(args, from, msgs...)
+	if nc.Sequences == nil {
+		return BroadcastMsgs(args, from, msgs...)
+	}
+	return broadcastWithSeqManager(args, from, msgs...)
 }
 
 func (nc *NibiruSDK) BroadcastMsgsGrpcWithSeq(
@@ -196,3 +230,42 @@ func (nc *NibiruSDK) BroadcastMsgsGrpcWithSeq(
 	args := initBroadcastArgs(nc, broadcaster)
 	return BroadcastMsgsWithSeq(args, from, seq, msgs...)
 }
+
+// TxPollInterval is how often [NibiruSDK.BroadcastAndWait] polls the CometBFT
+// RPC for the result of a tx.
+var TxPollInterval = 500 * time.Millisecond
+
+// BroadcastAndWait broadcasts a tx with the given msgs like
+// [NibiruSDK.BroadcastMsgs], then polls [NibiruSDK.TxByHash] until the tx is
+// included in a block or the timeout is reached. Returns an error if the tx
+// fails its checks or its execution.
+func (nc *NibiruSDK) BroadcastAndWait(
+	from sdk.AccAddress,
+	timeout time.Duration,
+	msgs ...sdk.Msg,
+) (*cmtcoretypes.ResultTx, error) {
+	txResp, err := nc.BroadcastMsgs(from, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if txResp.Code != 0 {
+		return nil, fmt.Errorf(
+			"tx %s failed its checks with code %d: %s", txResp.TxHash, txResp.Code, txResp.RawLog)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		txRes, err := nc.TxByHash(txResp.TxHash)
+		if err == nil {
+			if txRes.TxResult.Code != 0 {
+				return txRes, fmt.Errorf(
+					"tx %s failed with code %d: %s", txResp.TxHash, txRes.TxResult.Code, txRes.TxResult.Log)
+			}
+			return txRes, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("tx %s not included in a block after %s: %w", txResp.TxHash, timeout, err)
+		}
+		time.Sleep(TxPollInterval)
+	}
+}
@@ -0,0 +1,321 @@
+package gosdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtrpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	// DefaultPollInterval is how often an [EventStream] checks for new blocks
+	// when it gets no new block notification over the websocket.
+	DefaultPollInterval = 5 * time.Second
+	// DefaultReconnectBackoff is the wait before the first reconnection of an
+	// [EventStream]. The wait doubles with each failed reconnection, up to
+	// [MaxReconnectBackoff].
+	DefaultReconnectBackoff = time.Second
+	// MaxReconnectBackoff is the longest wait between two reconnections of an
+	// [EventStream].
+	MaxReconnectBackoff = time.Minute
+)
+
+// EventStream streams the blocks of a node, with their txs and events, over
+// the CometBFT RPC. New blocks are notified over the websocket, and the blocks
+// are fetched over HTTP in order, so that no block is skipped. When the
+// connection fails, the stream reconnects and resumes after the last block it
+// has sent.
+type EventStream struct {
+	// RPCEndpoint is the CometBFT RPC endpoint, in the form
+	// <protocol>://<host>:<port>.
+	RPCEndpoint string
+	// PollInterval is how often the stream checks for new blocks when it gets
+	// no new block notification.
+	PollInterval time.Duration
+	// ReconnectBackoff is the wait before the first reconnection.
+	ReconnectBackoff time.Duration
+	// OnError is called with the errors that make the stream reconnect. It
+	// can be nil.
+	OnError func(err error)
+}
+
+// NewEventStream returns an event stream of the node at the given CometBFT RPC
+// endpoint.
+func NewEventStream(rpcEndpt string) *EventStream {
+	return &EventStream{
+		RPCEndpoint:      rpcEndpt,
+		PollInterval:     DefaultPollInterval,
+		ReconnectBackoff: DefaultReconnectBackoff,
+	}
+}
+
+// BlockEvent is a block sent by an [EventStream], with the results of its
+// execution.
+type BlockEvent struct {
+	Block   *cmttypes.Block
+	Results *cmtcoretypes.ResultBlockResults
+}
+
+// Height returns the height of the block.
+func (b BlockEvent) Height() int64 { return b.Block.Height }
+
+// Events returns all the events of the block: the BeginBlock events, the
+// events of the txs and the EndBlock events.
+func (b BlockEvent) Events() []abci.Event {
+	events := append([]abci.Event{}, b.Results.BeginBlockEvents...)
+	for _, txResult := range b.Results.TxsResults {
+		events = append(events, txResult.Events...)
+	}
+	return append(events, b.Results.EndBlockEvents...)
+}
+
+// Txs returns the txs of the block with their results.
+func (b BlockEvent) Txs() []TxEvent {
+	txs := make([]TxEvent, 0, len(b.Block.Txs))
+	for i, tx := range b.Block.Txs {
+		if i >= len(b.Results.TxsResults) {
+			break
+		}
+		txs = append(txs, TxEvent{
+			Height: b.Block.Height,
+			Index:  uint32(i),
+			Hash:   fmt.Sprintf("%X", tx.Hash()),
+			Tx:     tx,
+			Result: *b.Results.TxsResults[i],
+		})
+	}
+	return txs
+}
+
+// TxEvent is a tx sent by an [EventStream], with the result of its execution.
+type TxEvent struct {
+	Height int64
+	Index  uint32
+	// Hash is the hex-encoded hash of the tx.
+	Hash   string
+	Tx     cmttypes.Tx
+	Result abci.ResponseDeliverTx
+}
+
+// EventAttribute is an event attribute to filter txs with.
+type EventAttribute struct {
+	// EventType is the type of the event, like "transfer" or the proto
+	// message name of a typed event.
+	EventType string
+	Key       string
+	// Value is the value of the attribute. An empty value matches any value.
+	Value string
+}
+
+// TxFilter selects the txs of an [EventStream]. A tx matches the filter if it
+// has one of the msg types, or any msg type if there are none, and all the
+// event attributes. Failed txs never match.
+type TxFilter struct {
+	// MsgTypes are type URLs of msgs, like "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypes   []string
+	Attributes []EventAttribute
+}
+
+// Match returns true if the result of a tx matches the filter.
+func (f TxFilter) Match(result abci.ResponseDeliverTx) bool {
+	if result.Code != 0 {
+		return false
+	}
+	if len(f.MsgTypes) > 0 {
+		found := false
+		for _, msgType := range f.MsgTypes {
+			if hasEventAttribute(result.Events, EventAttribute{
+				EventType: sdk.EventTypeMessage,
+				Key:       sdk.AttributeKeyAction,
+				Value:     msgType,
+			}) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, attr := range f.Attributes {
+		if !hasEventAttribute(result.Events, attr) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasEventAttribute(events []abci.Event, want EventAttribute) bool {
+	for _, event := range events {
+		if event.Type != want.EventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == want.Key && (want.Value == "" || attr.Value == want.Value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TypedEvents decodes the typed events of type T, like
+// *oracletypes.EventPriceUpdate or *evm.EventFunTokenCreated, among the given
+// events.
+func TypedEvents[T proto.Message](events []abci.Event) ([]T, error) {
+	var zero T
+	eventType := proto.MessageName(zero)
+	typedEvents := []T{}
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse event of type %s: %w", eventType, err)
+		}
+		typedEvent, ok := msg.(T)
+		if !ok {
+			return nil, fmt.Errorf("event of type %s parsed as %T", eventType, msg)
+		}
+		typedEvents = append(typedEvents, typedEvent)
+	}
+	return typedEvents, nil
+}
+
+// StreamBlocks streams the blocks of the node, in order, starting at
+// "fromHeight", or at the latest block if "fromHeight" is not positive. The
+// channel is closed when the context is done.
+func (s *EventStream) StreamBlocks(ctx context.Context, fromHeight int64) <-chan BlockEvent {
+	out := make(chan BlockEvent)
+	go func() {
+		defer close(out)
+		next := fromHeight
+		backoff := s.ReconnectBackoff
+		for {
+			sent, err := s.stream(ctx, &next, out)
+			if ctx.Err() != nil {
+				return
+			}
+			if s.OnError != nil {
+				s.OnError(err)
+			}
+			if sent {
+				backoff = s.ReconnectBackoff
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, MaxReconnectBackoff)
+		}
+	}()
+	return out
+}
+
+// StreamTxs streams the txs of the node that match the filter, in order,
+// starting at the block "fromHeight", or at the latest block if "fromHeight"
+// is not positive. The channel is closed when the context is done.
+func (s *EventStream) StreamTxs(
+	ctx context.Context, fromHeight int64, filter TxFilter,
+) <-chan TxEvent {
+	out := make(chan TxEvent)
+	blocks := s.StreamBlocks(ctx, fromHeight)
+	go func() {
+		defer close(out)
+		for block := range blocks {
+			for _, tx := range block.Txs() {
+				if !filter.Match(tx.Result) {
+					continue
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- tx:
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// stream connects to the node and sends the blocks from "next" on, until the
+// connection fails or the context is done. It keeps "next" at the height of
+// the next block to send, and reports whether it sent any block.
+func (s *EventStream) stream(
+	ctx context.Context, next *int64, out chan<- BlockEvent,
+) (sent bool, err error) {
+	client, err := NewRPCClient(s.RPCEndpoint, "/websocket")
+	if err != nil {
+		return false, err
+	}
+	if err := client.Start(); err != nil {
+		return false, fmt.Errorf("failed to start the websocket client: %w", err)
+	}
+	defer client.Stop() //nolint:errcheck
+
+	query := cmttypes.QueryForEvent(cmttypes.EventNewBlock).String()
+	newBlocks, err := client.Subscribe(ctx, "gosdk", query, 16)
+	if err != nil {
+		return false, fmt.Errorf("failed to subscribe to new blocks: %w", err)
+	}
+
+	if *next <= 0 {
+		status, err := client.Status(ctx)
+		if err != nil {
+			return false, err
+		}
+		*next = status.SyncInfo.LatestBlockHeight
+	}
+
+	ticker := time.NewTicker(s.PollInterval)
+	defer ticker.Stop()
+	for {
+		// Catch up to the latest block. New block notifications only wake up
+		// the stream, since the websocket may drop them.
+		status, err := client.Status(ctx)
+		if err != nil {
+			return sent, err
+		}
+		for ; *next <= status.SyncInfo.LatestBlockHeight; *next++ {
+			block, err := fetchBlock(ctx, client, *next)
+			if err != nil {
+				return sent, err
+			}
+			select {
+			case <-ctx.Done():
+				return sent, ctx.Err()
+			case out <- block:
+				sent = true
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return sent, ctx.Err()
+		case <-newBlocks:
+		case <-ticker.C:
+		}
+	}
+}
+
+func fetchBlock(
+	ctx context.Context, client *cmtrpchttp.HTTP, height int64,
+) (BlockEvent, error) {
+	block, err := client.Block(ctx, &height)
+	if err != nil {
+		return BlockEvent{}, fmt.Errorf("failed to query block %d: %w", height, err)
+	}
+	results, err := client.BlockResults(ctx, &height)
+	if err != nil {
+		return BlockEvent{}, fmt.Errorf("failed to query the results of block %d: %w", height, err)
+	}
+	return BlockEvent{Block: block.Block, Results: results}, nil
+}
@@ -0,0 +1,106 @@
+package gosdk_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/gosdk"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
+)
+
+func TestTxFilter(t *testing.T) {
+	msgType := "/nibiru.tokenfactory.v1.MsgCreateDenom"
+	result := abci.ResponseDeliverTx{
+		Events: []abci.Event{
+			{
+				Type: sdk.EventTypeMessage,
+				Attributes: []abci.EventAttribute{
+					{Key: sdk.AttributeKeyAction, Value: msgType},
+				},
+			},
+			{
+				Type: "transfer",
+				Attributes: []abci.EventAttribute{
+					{Key: "recipient", Value: "nibi1abc"},
+				},
+			},
+		},
+	}
+	for _, tc := range []struct {
+		name   string
+		filter gosdk.TxFilter
+		result abci.ResponseDeliverTx
+		want   bool
+	}{
+		{
+			name: "empty filter",
+			want: true,
+		},
+		{
+			name:   "matching msg type",
+			filter: gosdk.TxFilter{MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend", msgType}},
+			want:   true,
+		},
+		{
+			name:   "other msg type",
+			filter: gosdk.TxFilter{MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		},
+		{
+			name: "matching attributes",
+			filter: gosdk.TxFilter{
+				MsgTypes: []string{msgType},
+				Attributes: []gosdk.EventAttribute{
+					{EventType: "transfer", Key: "recipient", Value: "nibi1abc"},
+					{EventType: "transfer", Key: "recipient"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "other attribute value",
+			filter: gosdk.TxFilter{
+				Attributes: []gosdk.EventAttribute{
+					{EventType: "transfer", Key: "recipient", Value: "nibi1xyz"},
+				},
+			},
+		},
+		{
+			name: "failed tx",
+			result: abci.ResponseDeliverTx{
+				Code:   5,
+				Events: result.Events,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			txResult := result
+			if tc.result.Code != 0 {
+				txResult = tc.result
+			}
+			require.Equal(t, tc.want, tc.filter.Match(txResult))
+		})
+	}
+}
+
+func TestTypedEvents(t *testing.T) {
+	want := &tftypes.EventCreateDenom{Denom: "tf/nibi1abc/foo", Creator: "nibi1abc"}
+	typedEvent, err := sdk.TypedEventToEvent(want)
+	require.NoError(t, err)
+	events := []abci.Event{
+		{Type: "transfer"},
+		abci.Event(typedEvent),
+		{Type: sdk.EventTypeMessage},
+	}
+
+	got, err := gosdk.TypedEvents[*tftypes.EventCreateDenom](events)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, want, got[0])
+
+	none, err := gosdk.TypedEvents[*tftypes.EventMint](events)
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
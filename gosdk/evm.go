@@ -0,0 +1,265 @@
+package gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+// ReceiptPollInterval is how often [EVMClient.WaitForReceipt] polls the
+// JSON-RPC server for the receipt of a tx.
+var ReceiptPollInterval = 500 * time.Millisecond
+
+// EVMClient is the EVM sub-client of the SDK. It builds Ethereum txs, signs
+// them with the eth_secp256k1 keys of the SDK keyring, sends them to the
+// JSON-RPC server of a node and waits for their receipts.
+type EVMClient struct {
+	// EthClient is the client of the Ethereum JSON-RPC server.
+	EthClient *ethclient.Client
+	// ChainID is the EIP-155 chain ID of the network.
+	ChainID *big.Int
+
+	nc     *NibiruSDK
+	signer gethcore.Signer
+}
+
+// NewEVMClient connects to the Ethereum JSON-RPC server of a node and returns
+// an EVM client that signs txs with the keyring of the SDK.
+//
+// Args:
+//   - jsonRPCEndpt: endpoint in the form <protocol>://<host>:<port>
+func (nc *NibiruSDK) NewEVMClient(jsonRPCEndpt string) (*EVMClient, error) {
+	ethClient, err := ethclient.Dial(jsonRPCEndpt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot connect to JSON-RPC endpoint %s", err, jsonRPCEndpt)
+	}
+	chainID, err := ethClient.ChainID(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the chain ID: %w", err)
+	}
+	return &EVMClient{
+		EthClient: ethClient,
+		ChainID:   chainID,
+		nc:        nc,
+		signer:    gethcore.LatestSignerForChainID(chainID),
+	}, nil
+}
+
+// BuildTx builds an unsigned tx from "from". The nonce comes from the pending
+// state of the node, the gas limit from "eth_estimateGas" and the gas price
+// from "eth_gasPrice". A nil "to" builds a contract creation.
+func (c *EVMClient) BuildTx(
+	ctx context.Context,
+	from gethcommon.Address,
+	to *gethcommon.Address,
+	amountWei *big.Int,
+	input []byte,
+) (*evm.MsgEthereumTx, error) {
+	nonce, err := c.EthClient.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the nonce: %w", err)
+	}
+	gasPrice, err := c.EthClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the gas price: %w", err)
+	}
+	gasLimit, err := c.EthClient.EstimateGas(ctx, ethereum.CallMsg{
+		From:  from,
+		To:    to,
+		Value: amountWei,
+		Data:  input,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+
+	msg := evm.NewTx(&evm.EvmTxArgs{
+		Nonce:    nonce,
+		GasLimit: gasLimit,
+		Input:    input,
+		GasPrice: gasPrice,
+		ChainID:  c.ChainID,
+		Amount:   amountWei,
+		To:       to,
+	})
+	msg.From = from.Hex()
+	return msg, nil
+}
+
+// SignTx signs a tx with the key of its sender in the SDK keyring, which must
+// be an eth_secp256k1 key.
+func (c *EVMClient) SignTx(msg *evm.MsgEthereumTx) error {
+	return msg.Sign(c.signer, c.nc.Keyring)
+}
+
+// SendTx signs a tx and sends it with "eth_sendRawTransaction". It returns the
+// hash of the tx.
+func (c *EVMClient) SendTx(
+	ctx context.Context, msg *evm.MsgEthereumTx,
+) (gethcommon.Hash, error) {
+	if err := c.SignTx(msg); err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("failed to sign tx: %w", err)
+	}
+	tx := msg.AsTransaction()
+	if err := c.EthClient.SendTransaction(ctx, tx); err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// WaitForReceipt polls the JSON-RPC server for the receipt of a tx until the
+// tx is included in a block or the context is done.
+func (c *EVMClient) WaitForReceipt(
+	ctx context.Context, txHash gethcommon.Hash,
+) (*gethcore.Receipt, error) {
+	ticker := time.NewTicker(ReceiptPollInterval)
+	defer ticker.Stop()
+	for {
+		receipt, err := c.EthClient.TransactionReceipt(ctx, txHash)
+		if err == nil {
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, fmt.Errorf("failed to query the receipt of tx %s: %w", txHash, err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("tx %s not included in a block: %w", txHash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendTxAndWait builds, signs and sends a tx, then waits for its receipt.
+// Returns an error if the tx was included in a block but failed.
+func (c *EVMClient) SendTxAndWait(
+	ctx context.Context,
+	from gethcommon.Address,
+	to *gethcommon.Address,
+	amountWei *big.Int,
+	input []byte,
+) (*gethcore.Receipt, error) {
+	msg, err := c.BuildTx(ctx, from, to, amountWei, input)
+	if err != nil {
+		return nil, err
+	}
+	txHash, err := c.SendTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := c.WaitForReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != gethcore.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("tx %s failed", txHash)
+	}
+	return receipt, nil
+}
+
+// Transfer sends wei from "from" to "to" and waits for the receipt.
+func (c *EVMClient) Transfer(
+	ctx context.Context, from, to gethcommon.Address, amountWei *big.Int,
+) (*gethcore.Receipt, error) {
+	return c.SendTxAndWait(ctx, from, &to, amountWei, nil)
+}
+
+// DeployContract deploys a contract from its ABI and bytecode, with the given
+// constructor args, and waits for the receipt. It returns the address of the
+// deployed contract.
+func (c *EVMClient) DeployContract(
+	ctx context.Context,
+	from gethcommon.Address,
+	contractABI *gethabi.ABI,
+	bytecode []byte,
+	args ...any,
+) (gethcommon.Address, *gethcore.Receipt, error) {
+	packedArgs, err := contractABI.Pack("", args...)
+	if err != nil {
+		return gethcommon.Address{}, nil, fmt.Errorf("failed to pack constructor args: %w", err)
+	}
+	input := append(append([]byte{}, bytecode...), packedArgs...)
+	receipt, err := c.SendTxAndWait(ctx, from, nil, nil, input)
+	if err != nil {
+		return gethcommon.Address{}, receipt, err
+	}
+	return receipt.ContractAddress, receipt, nil
+}
+
+// DeployEmbeddedContract deploys one of the compiled contracts of
+// "x/evm/embeds".
+func (c *EVMClient) DeployEmbeddedContract(
+	ctx context.Context,
+	from gethcommon.Address,
+	contract embeds.CompiledEvmContract,
+	args ...any,
+) (gethcommon.Address, *gethcore.Receipt, error) {
+	return c.DeployContract(ctx, from, contract.ABI, contract.Bytecode, args...)
+}
+
+// ExecuteContract sends a tx that calls a method of a contract and waits for
+// the receipt.
+func (c *EVMClient) ExecuteContract(
+	ctx context.Context,
+	from gethcommon.Address,
+	contractAddr gethcommon.Address,
+	contractABI *gethabi.ABI,
+	method string,
+	args ...any,
+) (*gethcore.Receipt, error) {
+	input, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack args of method %s: %w", method, err)
+	}
+	return c.SendTxAndWait(ctx, from, &contractAddr, nil, input)
+}
+
+// CallContract calls a method of a contract with "eth_call" at the latest
+// block, without sending a tx, and returns the unpacked outputs.
+func (c *EVMClient) CallContract(
+	ctx context.Context,
+	from gethcommon.Address,
+	contractAddr gethcommon.Address,
+	contractABI *gethabi.ABI,
+	method string,
+	args ...any,
+) ([]any, error) {
+	input, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack args of method %s: %w", method, err)
+	}
+	out, err := c.EthClient.CallContract(ctx, ethereum.CallMsg{
+		From: from,
+		To:   &contractAddr,
+		Data: input,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return contractABI.Unpack(method, out)
+}
+
+// EthAddrOfKey returns the Ethereum address of a key of the SDK keyring.
+func (c *EVMClient) EthAddrOfKey(keyName string) (gethcommon.Address, error) {
+	record, err := c.nc.Keyring.Key(keyName)
+	if err != nil {
+		return gethcommon.Address{}, err
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return gethcommon.Address{}, err
+	}
+	return eth.NibiruAddrToEthAddr(addr), nil
+}
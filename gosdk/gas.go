@@ -0,0 +1,133 @@
+package gosdk
+
+import (
+	"fmt"
+
+	sdkclienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktypestx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+)
+
+const (
+	// DefaultGasAdjustment is the factor applied to the gas used by the
+	// simulation of a tx to get its gas limit. It leaves room for the state
+	// changing between the simulation and the execution of the tx.
+	DefaultGasAdjustment = 1.3
+	// DefaultGasPrice is the gas price, in unibi, used to compute the fees of
+	// a tx from its gas limit.
+	DefaultGasPrice = "0.025"
+)
+
+// TxOptions are the gas and fee options of the txs broadcast with the SDK.
+type TxOptions struct {
+	// GasLimit is the gas limit of the tx. If zero, the tx is simulated and
+	// its gas limit is the gas used by the simulation times GasAdjustment.
+	GasLimit uint64
+	// GasAdjustment is the factor applied to the gas used by the simulation.
+	GasAdjustment float64
+	// GasPrices are the prices used to compute the fees from the gas limit,
+	// with fee = ceil(gas price * gas limit). Ignored if Fees is set.
+	GasPrices sdk.DecCoins
+	// Fees are the fees of the tx. If set, they are used as is.
+	Fees sdk.Coins
+	// FeeGranter is an account that pays the fees of the tx through a fee
+	// grant to the signer. The txs of the SDK have a single signer, so a fee
+	// payer other than the signer is not supported.
+	FeeGranter sdk.AccAddress
+}
+
+// DefaultTxOptions returns the [TxOptions] of a new [NibiruSDK]: simulated gas
+// limits with [DefaultGasAdjustment] and fees at [DefaultGasPrice].
+func DefaultTxOptions() TxOptions {
+	return TxOptions{
+		GasAdjustment: DefaultGasAdjustment,
+		GasPrices: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(denoms.NIBI, sdk.MustNewDecFromStr(DefaultGasPrice)),
+		),
+	}
+}
+
+// Fee returns the fees of a tx with the given gas limit.
+func (opts TxOptions) Fee(gasLimit uint64) sdk.Coins {
+	if opts.Fees != nil {
+		return opts.Fees
+	}
+	gasLimitDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasLimit))
+	fees := sdk.NewCoins()
+	for _, gasPrice := range opts.GasPrices {
+		fee := gasPrice.Amount.Mul(gasLimitDec).Ceil().RoundInt()
+		fees = fees.Add(sdk.NewCoin(gasPrice.Denom, fee))
+	}
+	return fees
+}
+
+// WithTxOptions returns a copy of the SDK that broadcasts txs with the given
+// gas and fee options, to override them for some calls only.
+func (nc *NibiruSDK) WithTxOptions(opts TxOptions) *NibiruSDK {
+	sdkCopy := *nc
+	sdkCopy.TxOptions = opts
+	return &sdkCopy
+}
+
+// SimulateMsgs simulates a tx with the given msgs, signed by "from", over
+// gRPC. It returns the simulation response and the gas limit for the tx, which
+// is the gas used times the gas adjustment of the SDK.
+//
+// The simulated tx uses the account sequence of the latest block, so the
+// simulation fails while txs of "from" are still in the mempool. Use
+// [SimulateMsgs] to simulate with another sequence.
+func (nc *NibiruSDK) SimulateMsgs(
+	from sdk.AccAddress, msgs ...sdk.Msg,
+) (*sdktypestx.SimulateResponse, uint64, error) {
+	args := initBroadcastArgs(nc, nil)
+	nums, err := args.gosdk.GetAccountNumbers(from.String())
+	if err != nil {
+		return nil, 0, err
+	}
+	return SimulateMsgs(args, from, nums.Number, nums.Sequence, msgs...)
+}
+
+// EstimateFee returns the gas limit and the fees of a tx with the given msgs,
+// signed by "from", according to the [TxOptions] of the SDK.
+func (nc *NibiruSDK) EstimateFee(
+	from sdk.AccAddress, msgs ...sdk.Msg,
+) (gasLimit uint64, fees sdk.Coins, err error) {
+	gasLimit = nc.TxOptions.GasLimit
+	if gasLimit == 0 {
+		_, gasLimit, err = nc.SimulateMsgs(from, msgs...)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return gasLimit, nc.TxOptions.Fee(gasLimit), nil
+}
+
+// SimulateMsgs simulates a tx with the given msgs over the gRPC connection of
+// the broadcast args. The node simulates the tx on top of its mempool state, so
+// "seq" must be the next sequence of "from" including its pending txs, as for
+// a tx that is broadcast.
+func SimulateMsgs(
+	args BroadcastArgs,
+	from sdk.AccAddress,
+	accNum, seq uint64,
+	msgs ...sdk.Msg,
+) (*sdktypestx.SimulateResponse, uint64, error) {
+	info, err := args.kring.KeyByAddress(from)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The simulated tx pays a minimal fee so that the gas used to deduct the
+	// fees is part of the simulation.
+	txFactory := args.txFactory(accNum, seq).
+		WithFromName(info.Name).
+		WithSimulateAndExecute(true).
+		WithFees(args.TxOptions.Fee(1).String())
+	simRes, gasLimit, err := sdkclienttx.CalculateGas(args.grpc, txFactory, msgs...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to simulate tx: %w", err)
+	}
+	return simRes, gasLimit, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	csdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,6 +25,11 @@ type NibiruSDK struct {
 	CometRPC         cmtrpcclient.Client
 	AccountRetriever authtypes.AccountRetriever
 	GrpcClient       *grpc.ClientConn
+	// TxOptions are the gas and fee options of the broadcast txs.
+	TxOptions TxOptions
+	// Sequences hands out the account sequences of the txs broadcast without
+	// an explicit sequence.
+	Sequences *SequenceManager
 }
 
 func NewNibiruSdk(
@@ -32,8 +38,8 @@ func NewNibiruSdk(
 	rpcEndpt string,
 ) (NibiruSDK, error) {
 	EnsureNibiruPrefix()
-	encCfg := app.MakeEncodingConfig()
-	keyring := keyring.NewInMemory(encCfg.Codec)
+	encCfg := EncodingConfig()
+	keyring := keyring.NewInMemory(encCfg.Codec, ethhd.EthSecp256k1Option())
 	queryClient, err := NewQuerier(grpcConn)
 	if err != nil {
 		return NibiruSDK{}, err
@@ -50,6 +56,10 @@ func NewNibiruSdk(
 		CometRPC:         cometRpc,
 		AccountRetriever: authtypes.AccountRetriever{},
 		GrpcClient:       grpcConn,
+		TxOptions:        DefaultTxOptions(),
+		Sequences: NewSequenceManager(func(address string) (AccountNumbers, error) {
+			return GetAccountNumbers(address, grpcConn, encCfg)
+		}),
 	}, err
 }
 
@@ -1,20 +1,35 @@
 package gosdk_test
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 	"github.com/NibiruChain/nibiru/v2/gosdk"
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testnetwork"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 // --------------------------------------------------
@@ -92,6 +107,179 @@ func (s *TestSuite) TestNewNibiruSdk() {
 		s.DoTestBroadcastMsgsGrpc()
 	})
 	s.Run("DoTestNewQueryClient", s.DoTestNewQueryClient)
+	s.Run("DoTestEstimateFee", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestEstimateFee()
+	})
+	s.Run("DoTestBroadcastMsgsTxOptions", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestBroadcastMsgsTxOptions()
+	})
+	s.Run("DoTestBroadcastMsgsFeeGranter", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestBroadcastMsgsFeeGranter()
+	})
+	s.Run("DoTestEVMClient", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestEVMClient()
+	})
+	s.Run("DoTestBroadcastMsgsConcurrent", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestBroadcastMsgsConcurrent()
+	})
+	s.Run("DoTestBroadcastAndWait", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestBroadcastAndWait()
+	})
+	s.Run("DoTestEventStream", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestEventStream()
+	})
+}
+
+func (s *TestSuite) DoTestBroadcastMsgsConcurrent() {
+	from, _, _, msgSend := s.msgSendVars()
+	var wg sync.WaitGroup
+	txResps := make([]*sdk.TxResponse, 5)
+	errs := make([]error, len(txResps))
+	for i := range txResps {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			txResps[i], errs[i] = s.nibiruSdk.BroadcastMsgs(from, msgSend)
+		}(i)
+	}
+	wg.Wait()
+	for i := range txResps {
+		s.Require().NoError(errs[i])
+		s.AssertTxResponseSuccess(txResps[i])
+	}
+}
+
+func (s *TestSuite) DoTestBroadcastAndWait() {
+	from, _, _, msgSend := s.msgSendVars()
+
+	// A tx broadcast with an explicit sequence, outside the sequence
+	// manager, makes the next sequence of the manager stale.
+	nums, err := s.nibiruSdk.GetAccountNumbers(from.String())
+	s.Require().NoError(err)
+	txResp, err := s.nibiruSdk.BroadcastMsgsWithSeq(from, nums.Sequence, msgSend)
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+
+	txRes, err := s.nibiruSdk.BroadcastAndWait(from, 10*time.Second, msgSend)
+	s.Require().NoError(err)
+	s.EqualValues(0, txRes.TxResult.Code)
+	s.Greater(txRes.Height, int64(0))
+}
+
+func (s *TestSuite) DoTestEventStream() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	stream := gosdk.NewEventStream(s.RPCEndpoint())
+	stream.PollInterval = time.Second
+	stream.OnError = func(err error) { s.T().Log(err) }
+
+	// Blocks are streamed in order, without gaps.
+	blocks := stream.StreamBlocks(ctx, 0)
+	first := <-blocks
+	second := <-blocks
+	s.Equal(first.Height()+1, second.Height())
+	s.Equal(len(second.Block.Txs), len(second.Txs()))
+
+	// Txs are filtered by msg type and their typed events are decoded.
+	from, _, _, _ := s.msgSendVars()
+	msgCreateDenom := &tftypes.MsgCreateDenom{
+		Sender:   from.String(),
+		Subdenom: "streamed",
+	}
+	msgType := sdk.MsgTypeURL(msgCreateDenom)
+	s.Equal("/nibiru.tokenfactory.v1.MsgCreateDenom", msgType)
+	txs := stream.StreamTxs(ctx, second.Height(), gosdk.TxFilter{
+		MsgTypes: []string{msgType},
+	})
+
+	txRes, err := s.nibiruSdk.BroadcastAndWait(from, 10*time.Second, msgCreateDenom)
+	s.Require().NoError(err)
+
+	tx := <-txs
+	s.Require().NotEmpty(tx.Hash, "tx stream closed: %v", ctx.Err())
+	s.Equal(txRes.Height, tx.Height)
+	s.Equal(txRes.Hash.String(), tx.Hash)
+
+	events, err := gosdk.TypedEvents[*tftypes.EventCreateDenom](tx.Result.Events)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Equal(from.String(), events[0].Creator)
+	s.Equal(
+		tftypes.TFDenom{Creator: from.String(), Subdenom: "streamed"}.Denom().String(),
+		events[0].Denom,
+	)
+}
+
+func (s *TestSuite) DoTestEstimateFee() {
+	from, _, _, msgSend := s.msgSendVars()
+	simRes, gasLimit, err := s.nibiruSdk.SimulateMsgs(from, msgSend)
+	s.Require().NoError(err)
+	s.Greater(simRes.GasInfo.GasUsed, uint64(0))
+	s.EqualValues(
+		uint64(gosdk.DefaultGasAdjustment*float64(simRes.GasInfo.GasUsed)), gasLimit,
+	)
+
+	estGasLimit, fees, err := s.nibiruSdk.EstimateFee(from, msgSend)
+	s.Require().NoError(err)
+	s.InDelta(gasLimit, estGasLimit, float64(gasLimit)/10)
+	s.Equal(gosdk.DefaultTxOptions().Fee(estGasLimit), fees)
+	s.True(fees.AmountOf(denoms.NIBI).IsPositive())
+}
+
+func (s *TestSuite) DoTestBroadcastMsgsTxOptions() {
+	from, _, _, msgSend := s.msgSendVars()
+	opts := gosdk.TxOptions{
+		GasLimit: 500_000,
+		Fees:     sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1234)),
+	}
+	txResp, err := s.nibiruSdk.WithTxOptions(opts).BroadcastMsgs(from, msgSend)
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+	s.NoError(s.network.WaitForNextBlock())
+
+	txRes, err := s.nibiruSdk.TxByHash(txResp.TxHash)
+	s.Require().NoError(err)
+	s.EqualValues(opts.GasLimit, txRes.TxResult.GasWanted)
+
+	// The per-call override leaves the options of the SDK untouched.
+	s.Equal(gosdk.DefaultTxOptions(), s.nibiruSdk.TxOptions)
+}
+
+func (s *TestSuite) DoTestBroadcastMsgsFeeGranter() {
+	granter, to, amt, _ := s.msgSendVars()
+	grantee, err := gosdk.AddSignerToKeyringSecp256k1(s.nibiruSdk.Keyring, "", "fee-grantee")
+	s.Require().NoError(err)
+
+	s.T().Log("Fund the grantee and grant it an allowance for its fees")
+	fundAmt := sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000))
+	msgGrant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, granter, grantee)
+	s.Require().NoError(err)
+	_, err = s.nibiruSdk.BroadcastAndWait(
+		granter, 10*time.Second, banktypes.NewMsgSend(granter, grantee, fundAmt), msgGrant,
+	)
+	s.Require().NoError(err)
+
+	s.T().Log("The granter pays the fees of the txs of the grantee")
+	opts := gosdk.DefaultTxOptions()
+	opts.FeeGranter = granter
+	_, err = s.nibiruSdk.WithTxOptions(opts).BroadcastAndWait(
+		grantee, 10*time.Second, banktypes.NewMsgSend(grantee, to, amt),
+	)
+	s.Require().NoError(err)
+
+	bankQuery := banktypes.NewQueryClient(s.grpcConn)
+	balance, err := bankQuery.Balance(context.Background(), &banktypes.QueryBalanceRequest{
+		Address: grantee.String(), Denom: denoms.NIBI,
+	})
+	s.Require().NoError(err)
+	s.Equal(fundAmt.Sub(amt...).AmountOf(denoms.NIBI).String(), balance.Balance.Amount.String())
 }
 
 // FIXME: Q: What is the node home for a local validator?
@@ -140,6 +328,60 @@ func (s *TestSuite) DoTestBroadcastMsgsGrpc() (txHashHex string) {
 	return txHashHex
 }
 
+func (s *TestSuite) DoTestEVMClient() {
+	// The SDK keyring needs an eth_secp256k1 key to sign Ethereum txs.
+	kring := gosdk.NewKeyring()
+	keyName := "evm-user"
+	nibiAddr, err := gosdk.AddSignerToKeyring(kring, "", keyName, ethhd.EthSecp256k1)
+	s.Require().NoError(err)
+
+	from, _, _, _ := s.msgSendVars()
+	funds := sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10_000_000))
+	txResp, err := s.nibiruSdk.BroadcastMsgs(from, banktypes.NewMsgSend(from, nibiAddr, funds))
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+	s.NoError(s.network.WaitForNextBlock())
+
+	evmSdk := *s.nibiruSdk
+	evmSdk.Keyring = kring
+	evmClient, err := evmSdk.NewEVMClient("http://" + s.val.AppConfig.JSONRPC.Address)
+	s.Require().NoError(err)
+	s.Equal(appconst.GetEthChainID(s.cfg.ChainID), evmClient.ChainID)
+
+	ethAddr, err := evmClient.EthAddrOfKey(keyName)
+	s.Require().NoError(err)
+	s.Equal(eth.NibiruAddrToEthAddr(nibiAddr), ethAddr)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	contract := embeds.SmartContract_TestERC20
+	contractAddr, receipt, err := evmClient.DeployEmbeddedContract(ctx, ethAddr, contract)
+	s.Require().NoError(err)
+	s.NotEqual(gethcommon.Address{}, contractAddr)
+	s.Equal(gethcore.ReceiptStatusSuccessful, receipt.Status)
+
+	recipient := evmtest.NewEthPrivAcc().EthAddr
+	receipt, err = evmClient.ExecuteContract(
+		ctx, ethAddr, contractAddr, contract.ABI, "transfer", recipient, big.NewInt(420),
+	)
+	s.Require().NoError(err)
+	s.Len(receipt.Logs, 1)
+
+	out, err := evmClient.CallContract(
+		ctx, ethAddr, contractAddr, contract.ABI, "balanceOf", recipient,
+	)
+	s.Require().NoError(err)
+	s.Equal(big.NewInt(420), out[0])
+
+	amountWei := evm.NativeToWei(big.NewInt(1))
+	_, err = evmClient.Transfer(ctx, ethAddr, recipient, amountWei)
+	s.Require().NoError(err)
+	balance, err := evmClient.EthClient.BalanceAt(ctx, recipient, nil)
+	s.Require().NoError(err)
+	s.Equal(amountWei, balance)
+}
+
 func (s *TestSuite) TearDownSuite() {
 	s.T().Log("tearing down integration test suite")
 	s.network.Cleanup()
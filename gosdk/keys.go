@@ -9,15 +9,24 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/app/codec"
+	ethcryptocodec "github.com/NibiruChain/nibiru/v2/eth/crypto/codec"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 )
 
-func EncodingConfig() codec.EncodingConfig { return app.MakeEncodingConfig() }
+// EncodingConfig: The encoding config of the app, with the eth_secp256k1 key
+// types registered so that keyrings can store Ethereum keys.
+func EncodingConfig() codec.EncodingConfig {
+	encCfg := app.MakeEncodingConfig()
+	ethcryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+	return encCfg
+}
 
-// NewKeyring: Creates an empty, in-memory keyring
+// NewKeyring: Creates an empty, in-memory keyring that supports both
+// secp256k1 and eth_secp256k1 keys.
 func NewKeyring() keyring.Keyring {
-	return keyring.NewInMemory(EncodingConfig().Codec)
+	return keyring.NewInMemory(EncodingConfig().Codec, ethhd.EthSecp256k1Option())
 }
 
 // TODO: Is it necessary to add support for interacting with local file system
@@ -40,17 +49,28 @@ func NewKeyring() keyring.Keyring {
 // 	)
 // }
 
-func AddSignerToKeyringSecp256k1(
-	kring keyring.Keyring, mnemonic string, keyName string,
+// AddSignerToKeyring: Adds a key derived from "mnemonic" with the signing
+// algorithm "algo" to the keyring, overwriting any key named "keyName". An
+// empty mnemonic generates a new key. Use [hd.Secp256k1] for Cosmos accounts
+// and [ethhd.EthSecp256k1] for Ethereum accounts, which need a keyring that
+// supports the algorithm, like the one of [NewKeyring].
+func AddSignerToKeyring(
+	kring keyring.Keyring, mnemonic string, keyName string, algo keyring.SignatureAlgo,
 ) (sdk.AccAddress, error) {
-	algo := hd.Secp256k1
 	overwrite := true
-	addr, secretMnem, err := sdktestutil.GenerateSaveCoinKey(
+	addr, _, err := sdktestutil.GenerateSaveCoinKey(
 		kring, keyName, mnemonic, overwrite, algo,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("%w : Failed Key Generation with mnemonic %s", err, secretMnem)
+		return nil, fmt.Errorf("failed to add %s key %q to the keyring: %w", algo.Name(), keyName, err)
 	}
+	return addr, nil
+}
 
-	return addr, err
+// AddSignerToKeyringSecp256k1: Adds a secp256k1 key to the keyring. See
+// [AddSignerToKeyring].
+func AddSignerToKeyringSecp256k1(
+	kring keyring.Keyring, mnemonic string, keyName string,
+) (sdk.AccAddress, error) {
+	return AddSignerToKeyring(kring, mnemonic, keyName, hd.Secp256k1)
 }
@@ -40,6 +40,8 @@ func TestCreateSigner(t *testing.T) {
 
 			if tc.expectErr {
 				require.Error(t, err)
+				// The error must not leak the mnemonic.
+				require.NotContains(t, err.Error(), tc.mnemonic)
 				return
 			}
 
@@ -0,0 +1,217 @@
+package gosdk
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a tx is broadcast again after
+	// an account sequence mismatch.
+	DefaultMaxRetries = 5
+	// DefaultRetryBackoff is the wait before the first retry of a broadcast.
+	// The wait doubles with each retry.
+	DefaultRetryBackoff = 200 * time.Millisecond
+)
+
+// seqMismatchRegex matches the error of the ante handler on a wrong account
+// sequence and captures the expected sequence.
+var seqMismatchRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+), got \d+`)
+
+// SequenceManager hands out the account sequences of the signers of an SDK.
+// It is safe for concurrent use: each call to [SequenceManager.Next] returns a
+// different sequence, so that txs broadcast from parallel goroutines don't
+// collide. The sequences are resynced from the chain on mismatch errors.
+//
+// The SDK signs and checks the txs of a signer one at a time, with the lock of
+// the signer, since the node rejects a tx whose sequence is ahead of the txs
+// it has checked. The txs of different signers are broadcast in parallel.
+type SequenceManager struct {
+	// MaxRetries is the number of times a tx is broadcast again after an
+	// account sequence mismatch.
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry of a broadcast, which
+	// doubles with each retry.
+	RetryBackoff time.Duration
+
+	mu          sync.Mutex
+	accounts    map[string]*AccountNumbers
+	signerLocks map[string]*sync.Mutex
+	query       func(address string) (AccountNumbers, error)
+}
+
+// NewSequenceManager returns a sequence manager that queries the account
+// numbers of the signers with "query", like [NibiruSDK.GetAccountNumbers].
+func NewSequenceManager(
+	query func(address string) (AccountNumbers, error),
+) *SequenceManager {
+	return &SequenceManager{
+		MaxRetries:   DefaultMaxRetries,
+		RetryBackoff: DefaultRetryBackoff,
+		accounts:     make(map[string]*AccountNumbers),
+		signerLocks:  make(map[string]*sync.Mutex),
+		query:        query,
+	}
+}
+
+// LockSigner locks the signer until the returned unlock function is called.
+// The SDK holds the lock of a signer from the moment it takes a sequence until
+// the node has checked the tx.
+func (sm *SequenceManager) LockSigner(signer sdk.AccAddress) (unlock func()) {
+	sm.mu.Lock()
+	lock, ok := sm.signerLocks[signer.String()]
+	if !ok {
+		lock = new(sync.Mutex)
+		sm.signerLocks[signer.String()] = lock
+	}
+	sm.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// Next returns the account number of the signer and the next sequence to sign
+// a tx with. The sequences of a signer are queried from the chain the first
+// time and then incremented locally.
+func (sm *SequenceManager) Next(signer sdk.AccAddress) (AccountNumbers, error) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	nums, ok := sm.accounts[signer.String()]
+	if !ok {
+		queried, err := sm.query(signer.String())
+		if err != nil {
+			return AccountNumbers{}, err
+		}
+		nums = &queried
+		sm.accounts[signer.String()] = nums
+	}
+	next := *nums
+	nums.Sequence++
+	return next, nil
+}
+
+// Release gives back a sequence returned by [SequenceManager.Next] that wasn't
+// used by a tx, like when the tx failed its checks. The sequence is only given
+// back if no later sequence was handed out.
+func (sm *SequenceManager) Release(signer sdk.AccAddress, seq uint64) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if nums, ok := sm.accounts[signer.String()]; ok && nums.Sequence == seq+1 {
+		nums.Sequence = seq
+	}
+}
+
+// Resync sets the next sequence of the signer from the chain. The sequence on
+// chain doesn't count the txs in the mempool, so the sequence expected by the
+// node, taken from a mismatch error, is used if it is higher.
+func (sm *SequenceManager) Resync(signer sdk.AccAddress, expectedSeq *uint64) error {
+	queried, err := sm.query(signer.String())
+	if err != nil {
+		return err
+	}
+	if expectedSeq != nil && *expectedSeq > queried.Sequence {
+		queried.Sequence = *expectedSeq
+	}
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.accounts[signer.String()] = &queried
+	return nil
+}
+
+// Reset forgets the sequences of all the signers. They are queried from the
+// chain again on the next call to [SequenceManager.Next].
+func (sm *SequenceManager) Reset() {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.accounts = make(map[string]*AccountNumbers)
+}
+
+// IsSeqMismatch returns true if a broadcast failed on a wrong account
+// sequence, either with an error or in the CheckTx response. On a mismatch, it
+// also returns the sequence expected by the node if the error has it.
+func IsSeqMismatch(txResp *sdk.TxResponse, err error) (bool, *uint64) {
+	var log string
+	switch {
+	case err != nil:
+		log = err.Error()
+	case txResp != nil &&
+		txResp.Codespace == sdkerrors.ErrWrongSequence.Codespace() &&
+		txResp.Code == sdkerrors.ErrWrongSequence.ABCICode():
+		log = txResp.RawLog
+	default:
+		return false, nil
+	}
+
+	match := seqMismatchRegex.FindStringSubmatch(log)
+	if match == nil {
+		return false, nil
+	}
+	expected, parseErr := strconv.ParseUint(match[1], 10, 64)
+	if parseErr != nil {
+		return true, nil
+	}
+	return true, &expected
+}
+
+// broadcastWithSeqManager broadcasts msgs with the next sequence of the signer
+// from the sequence manager of the SDK. On an account sequence mismatch, it
+// resyncs the sequence and broadcasts again, with backoff, up to the max
+// number of retries.
+func broadcastWithSeqManager(
+	args BroadcastArgs,
+	from sdk.AccAddress,
+	msgs ...sdk.Msg,
+) (*sdk.TxResponse, error) {
+	sm := args.gosdk.Sequences
+	backoff := sm.RetryBackoff
+	for retry := 0; ; retry++ {
+		txResp, mismatch, err := broadcastWithNextSeq(args, from, msgs...)
+		if !mismatch || retry >= sm.MaxRetries {
+			return txResp, err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// broadcastWithNextSeq broadcasts msgs with the next sequence of the signer,
+// holding the lock of the signer. If the tx fails its checks, the sequence is
+// released, or resynced if the failure is an account sequence mismatch. A
+// mismatch means that another client broadcast txs of the signer, or that a
+// tx of the SDK was dropped from the mempool.
+func broadcastWithNextSeq(
+	args BroadcastArgs,
+	from sdk.AccAddress,
+	msgs ...sdk.Msg,
+) (txResp *sdk.TxResponse, mismatch bool, err error) {
+	sm := args.gosdk.Sequences
+	unlock := sm.LockSigner(from)
+	defer unlock()
+
+	nums, err := sm.Next(from)
+	if err != nil {
+		return nil, false, err
+	}
+	txResp, err = BroadcastMsgsWithSeq(args, from, nums.Sequence, msgs...)
+	if err == nil && txResp.Code == 0 {
+		return txResp, false, nil
+	}
+
+	mismatch, expectedSeq := IsSeqMismatch(txResp, err)
+	if !mismatch {
+		sm.Release(from, nums.Sequence)
+		return txResp, false, err
+	}
+	if resyncErr := sm.Resync(from, expectedSeq); resyncErr != nil {
+		return nil, false, resyncErr
+	}
+	return txResp, true, err
+}
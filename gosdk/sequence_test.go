@@ -0,0 +1,128 @@
+package gosdk_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/gosdk"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+)
+
+func TestIsSeqMismatch(t *testing.T) {
+	mismatchLog := "account sequence mismatch, expected 7, got 5: incorrect account sequence"
+	for _, tc := range []struct {
+		name         string
+		txResp       *sdk.TxResponse
+		err          error
+		wantMismatch bool
+		wantExpected uint64
+	}{
+		{
+			name:   "tx passed its checks",
+			txResp: &sdk.TxResponse{Code: 0},
+		},
+		{
+			name: "tx failed its checks on another error",
+			txResp: &sdk.TxResponse{
+				Codespace: sdkerrors.ErrInsufficientFunds.Codespace(),
+				Code:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+				RawLog:    "insufficient funds",
+			},
+		},
+		{
+			name: "mismatch in the CheckTx response",
+			txResp: &sdk.TxResponse{
+				Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+				Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+				RawLog:    mismatchLog,
+			},
+			wantMismatch: true,
+			wantExpected: 7,
+		},
+		{
+			name:         "mismatch in the simulation error",
+			err:          errors.New("failed to simulate tx: rpc error: code = Unknown desc = " + mismatchLog),
+			wantMismatch: true,
+			wantExpected: 7,
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mismatch, expected := gosdk.IsSeqMismatch(tc.txResp, tc.err)
+			require.Equal(t, tc.wantMismatch, mismatch)
+			if !tc.wantMismatch {
+				require.Nil(t, expected)
+				return
+			}
+			require.NotNil(t, expected)
+			require.Equal(t, tc.wantExpected, *expected)
+		})
+	}
+}
+
+func TestSequenceManager(t *testing.T) {
+	signer := testutil.AccAddress()
+	onChain := gosdk.AccountNumbers{Number: 3, Sequence: 10}
+	queries := 0
+	sm := gosdk.NewSequenceManager(func(address string) (gosdk.AccountNumbers, error) {
+		require.Equal(t, signer.String(), address)
+		queries++
+		return onChain, nil
+	})
+
+	// Concurrent callers get distinct sequences, queried from the chain once.
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	seqs := make(map[uint64]bool)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nums, err := sm.Next(signer)
+			require.NoError(t, err)
+			require.Equal(t, onChain.Number, nums.Number)
+			mu.Lock()
+			seqs[nums.Sequence] = true
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	require.Len(t, seqs, 10)
+	for seq := uint64(10); seq < 20; seq++ {
+		require.True(t, seqs[seq], "missing sequence %d", seq)
+	}
+	require.Equal(t, 1, queries)
+
+	// Only the last sequence handed out can be released.
+	sm.Release(signer, 15)
+	nums, err := sm.Next(signer)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, nums.Sequence)
+	sm.Release(signer, 20)
+	nums, err = sm.Next(signer)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, nums.Sequence)
+
+	// Resync takes the sequence expected by the node if it is ahead of the
+	// chain, and the sequence on chain otherwise.
+	expected := uint64(12)
+	require.NoError(t, sm.Resync(signer, &expected))
+	nums, err = sm.Next(signer)
+	require.NoError(t, err)
+	require.EqualValues(t, 12, nums.Sequence)
+
+	expected = 4
+	require.NoError(t, sm.Resync(signer, &expected))
+	nums, err = sm.Next(signer)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, nums.Sequence)
+	require.Equal(t, 3, queries)
+}
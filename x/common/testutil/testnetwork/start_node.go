@@ -133,7 +133,9 @@ func startNodeAndServers(cfg Config, val *Validator) error {
 
 		val.Logger.Log("Set EVM indexer")
 
-		evmTxIndexer, evmTxIndexerService, err := server.OpenEVMIndexer(val.Ctx, db.NewMemDB(), val.ClientCtx)
+		evmTxIndexer, evmTxIndexerService, err := server.OpenEVMIndexer(
+			val.Ctx, db.NewMemDB(), val.ClientCtx, val.AppConfig.JSONRPC.IndexerStartHeight,
+		)
 		if err != nil {
 			{
 				return fmt.Errorf("failed starting evm indexer service: %w", err)
@@ -0,0 +1,66 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// AssertAuthzMsgsAllowed rejects the authz messages that would run a
+// [MsgEthereumTx] outside of a tx with the "ExtensionOptionsEthereumTx"
+// option: generic grants for [MsgEthereumTx] and a [authz.MsgExec] with a
+// [MsgEthereumTx] inside, including in nested execs. Other messages are
+// allowed.
+//
+// It is used by the authz guard of the ante handler and for the messages of
+// the proposals submitted by the governance precompile.
+func AssertAuthzMsgsAllowed(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *authz.MsgGrant:
+			if msg.Grant.Authorization == nil {
+				return errors.Wrapf(
+					errortypes.ErrInvalidType,
+					"grant authorization is missing",
+				)
+			}
+			authorization, err := msg.Grant.GetAuthorization()
+			if err != nil {
+				return errors.Wrapf(
+					errortypes.ErrInvalidType,
+					"failed unmarshaling generic authorization %s", err,
+				)
+			}
+			if genericAuth, ok := authorization.(*authz.GenericAuthorization); ok &&
+				genericAuth.MsgTypeURL() == sdk.MsgTypeURL(&MsgEthereumTx{}) {
+				return errors.Wrapf(
+					errortypes.ErrNotSupported,
+					"authz grant generic for msg type %s is not allowed",
+					genericAuth.MsgTypeURL(),
+				)
+			}
+		case *authz.MsgExec:
+			msgsInExec, err := msg.GetMessages()
+			if err != nil {
+				return errors.Wrapf(
+					errortypes.ErrInvalidType,
+					"failed getting exec messages %s", err,
+				)
+			}
+			for _, msgInExec := range msgsInExec {
+				if _, ok := msgInExec.(*MsgEthereumTx); ok {
+					return errors.Wrapf(
+						errortypes.ErrInvalidType,
+						"MsgEthereumTx needs to be contained within a tx with 'ExtensionOptionsEthereumTx' option",
+					)
+				}
+			}
+			if err := AssertAuthzMsgsAllowed(msgsInExec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
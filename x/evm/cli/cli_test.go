@@ -49,6 +49,48 @@ func (s *Suite) TestCmdConvertCoinToEvm() {
 	}
 }
 
+func (s *Suite) TestCmdConvertEvmToCoin() {
+	testCases := []TestCase{
+		{
+			name: "happy: convert-evm-to-coin",
+			args: []string{
+				"convert-evm-to-coin",
+				dummyFuntoken.Erc20Addr.String(),
+				"123",
+				s.testAcc.Address.String(),
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "",
+		},
+		{
+			name: "sad: amount format",
+			args: []string{
+				"convert-evm-to-coin",
+				dummyFuntoken.Erc20Addr.String(),
+				"12.3",
+				s.testAcc.Address.String(),
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "invalid amount",
+		},
+		{
+			name: "sad: to_bank_addr format",
+			args: []string{
+				"convert-evm-to-coin",
+				dummyFuntoken.Erc20Addr.String(),
+				"123",
+				dummyEthAddr,
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "decoding bech32 failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunTxCmd(s)
+	}
+}
+
 func (s *Suite) TestCmdCreateFunToken() {
 	testCases := []TestCase{
 		{
@@ -28,6 +28,7 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		CmdCreateFunToken(),
 		CmdConvertCoinToEvm(),
+		CmdConvertEvmToCoin(),
 	}
 	for _, cmd := range cmds {
 		txCmd.AddCommand(cmd)
@@ -123,3 +124,42 @@ func CmdConvertCoinToEvm() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdConvertEvmToCoin broadcast MsgConvertEvmToCoin
+func CmdConvertEvmToCoin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-evm-to-coin [erc20] [amount] [to_bank_addr] [flags]",
+		Short: `Convert [amount] of [erc20] tokens to their bank coin representation and send to the [to_bank_addr] account`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			eip55Addr, err := eth.NewEIP55AddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount \"%s\"", args[1])
+			}
+
+			toBankAddr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+			msg := &evm.MsgConvertEvmToCoin{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Erc20Addr:  eip55Addr,
+				Amount:     amount,
+				ToBankAddr: toBankAddr.String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
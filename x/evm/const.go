@@ -10,8 +10,9 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
-// BASE_FEE_MICRONIBI is the global base fee value for the network. It has a
-// constant value of 1 unibi (micronibi) == 10^12 wei.
+// BASE_FEE_MICRONIBI is the default floor of the base fee for the network,
+// 1 unibi (micronibi) == 10^12 wei. The base fee of a block is dynamic and
+// bounded below by the "min_base_fee" of the module params.
 var (
 	BASE_FEE_MICRONIBI = big.NewInt(1)
 	BASE_FEE_WEI       = NativeToWei(BASE_FEE_MICRONIBI)
@@ -46,6 +47,8 @@ const (
 	KeyPrefixFunTokenIdxErc20
 	// KV store prefix for indexing `FunToken` by bank coin denomination
 	KeyPrefixFunTokenIdxBankDenom
+	// KV store prefix for the EIP-1559 base fee of the next block
+	KeyPrefixBaseFee
 )
 
 // KVStore transient prefix namespaces for the EVM Module. Transient stores only
@@ -55,6 +58,7 @@ const (
 	NamespaceBlockTxIndex
 	NamespaceBlockLogSize
 	NamespaceBlockGasUsed
+	NamespaceBlockEthGasUsed
 )
 
 var KeyPrefixBzAccState = KeyPrefixAccState.Prefix()
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant BANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+IBank constant BANK_PRECOMPILE = IBank(BANK_PRECOMPILE_ADDRESS);
+
+/// @notice Bank interface for transferring and querying native bank coins
+/// from the EVM, including coins without a FunToken mapping such as IBC
+/// vouchers and tokenfactory denoms.
+/// @dev All amounts are in the smallest unit of the bank denom. Transfers of
+/// "unibi" are reflected in the EVM balances (wei) of the accounts involved.
+interface IBank {
+    struct BankCoin {
+        string denom;
+        uint256 amount;
+    }
+
+    struct DenomUnit {
+        string denom;
+        uint32 exponent;
+        string[] aliases;
+    }
+
+    struct DenomMetadata {
+        string description;
+        DenomUnit[] denomUnits;
+        string base;
+        string display;
+        string name;
+        string symbol;
+    }
+
+    /// @notice Emitted when the caller sends coins with "send".
+    /// @param from the caller of the precompile
+    /// @param to the receiving Bech32 (nibi) address
+    /// @param denom the bank denomination of the coins sent
+    /// @param amount the amount of coins sent
+    event Send(
+        address indexed from,
+        string to,
+        string denom,
+        uint256 amount
+    );
+
+    /// @notice Sends bank coins from the caller to another account.
+    /// @param to Recipient address as either a hex (0x) or Bech32 (nibi)
+    /// encoded string
+    /// @param denom Bank denomination of the coins to send
+    /// @param amount Amount of coins to send
+    /// @return success True if the transfer succeeded
+    function send(
+        string memory to,
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @notice Queries the bank balance of an account for a single denom.
+    /// @param who Ethereum address of the account
+    /// @param denom Bank denomination
+    /// @return amount Balance of the account
+    function balanceOf(
+        address who,
+        string memory denom
+    ) external view returns (uint256 amount);
+
+    /// @notice Queries all bank balances of an account.
+    /// @param who Ethereum address of the account
+    /// @return balances Every nonzero balance of the account, sorted by denom
+    function allBalances(
+        address who
+    ) external view returns (BankCoin[] memory balances);
+
+    /// @notice Queries the total supply of a bank denom.
+    /// @param denom Bank denomination
+    /// @return amount Total supply of the denom
+    function totalSupply(
+        string memory denom
+    ) external view returns (uint256 amount);
+
+    /// @notice Queries the metadata of a bank denom.
+    /// @param denom Bank denomination
+    /// @return metadata Metadata registered for the denom. Reverts if the
+    /// denom has no metadata.
+    function denomMetadata(
+        string memory denom
+    ) external view returns (DenomMetadata memory metadata);
+}
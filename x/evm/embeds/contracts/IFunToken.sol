@@ -8,6 +8,30 @@ IFunToken constant FUNTOKEN_PRECOMPILE = IFunToken(FUNTOKEN_PRECOMPILE_ADDRESS);
 /// coins to various Nibiru accounts using either the Nibiru Bech32 address
 /// using the "FunToken" mapping between the ERC20 and bank.
 interface IFunToken {
+    /// @notice Emitted when ERC20 tokens are sent as bank coins with "sendToBank".
+    /// @param sender - the caller whose ERC20 tokens were sent
+    /// @param erc20 - the address of the ERC20 token contract
+    /// @param to - the receiving Nibiru base account address
+    /// @param amount - the amount of coins received by "to"
+    event SendToBank(
+        address indexed sender,
+        address indexed erc20,
+        string to,
+        uint256 amount
+    );
+
+    /// @notice Emitted when bank coins are sent as ERC20 tokens with "sendToEvm".
+    /// @param sender - the caller whose bank coins were sent
+    /// @param bankDenom - the bank denomination of the coins sent
+    /// @param to - the receiving Ethereum address
+    /// @param amount - the amount of ERC20 tokens received by "to"
+    event SendToEvm(
+        address indexed sender,
+        string bankDenom,
+        address indexed to,
+        uint256 amount
+    );
+
     /// @dev sendToBank sends ERC20 tokens as coins to a Nibiru base account
     /// @param erc20 - the address of the ERC20 token contract
     /// @param amount - the amount of tokens to send
@@ -21,6 +45,21 @@ interface IFunToken {
         string calldata to
     ) external returns (uint256 sentAmount);
 
+    /// @dev sendToEvm sends bank coins as ERC20 tokens to an Ethereum address
+    /// using the "FunToken" mapping between the bank coin and the ERC20
+    /// @param bankDenom - the bank denomination of the coins to send
+    /// @param amount - the amount of coins to send
+    /// @param to - the receiving address as either a hex (0x) or Bech32
+    /// (nibi) encoded string
+    /// @return sentAmount - amount of ERC20 tokens received by the recipient.
+    /// This may not be equal to `amount` if the corresponding ERC20 contract
+    /// has a fee or deduction on transfer.
+    function sendToEvm(
+        string calldata bankDenom,
+        uint256 amount,
+        string calldata to
+    ) external returns (uint256 sentAmount);
+
     struct NibiruAccount {
         address ethAddr;
         string bech32Addr;
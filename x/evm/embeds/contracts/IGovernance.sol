@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant GOVERNANCE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+IGovernance constant GOVERNANCE_PRECOMPILE = IGovernance(
+    GOVERNANCE_PRECOMPILE_ADDRESS
+);
+
+/// @notice Governance interface for submitting proposals, depositing, and
+/// voting in "x/gov" from the EVM.
+/// @dev The caller of each mutating method is the proposer, depositor, or
+/// voter. Vote options use the values of the "x/gov" "VoteOption" enum:
+/// 1 = Yes, 2 = Abstain, 3 = No, 4 = NoWithVeto. Proposal statuses use the
+/// values of the "ProposalStatus" enum: 1 = DepositPeriod,
+/// 2 = VotingPeriod, 3 = Passed, 4 = Rejected, 5 = Failed.
+interface IGovernance {
+    struct BankCoin {
+        string denom;
+        uint256 amount;
+    }
+
+    struct WeightedVoteOption {
+        uint8 option;
+        uint256 weight;
+    }
+
+    struct TallyResult {
+        uint256 yes;
+        uint256 abstain;
+        uint256 no;
+        uint256 noWithVeto;
+    }
+
+    struct Proposal {
+        uint64 id;
+        uint8 status;
+        string proposer;
+        string title;
+        string summary;
+        string metadata;
+        BankCoin[] totalDeposit;
+        uint64 submitTime;
+        uint64 depositEndTime;
+        uint64 votingStartTime;
+        uint64 votingEndTime;
+    }
+
+    /// @notice Emitted when the caller submits a proposal with "submitProposal".
+    event SubmitProposal(address indexed proposer, uint64 indexed proposalId);
+
+    /// @notice Emitted when the caller deposits to a proposal with "deposit".
+    event Deposit(
+        address indexed depositor,
+        uint64 indexed proposalId,
+        BankCoin[] amount
+    );
+
+    /// @notice Emitted when the caller votes on a proposal with "vote".
+    event Vote(address indexed voter, uint64 indexed proposalId, uint8 option);
+
+    /// @notice Emitted when the caller casts a weighted vote on a proposal with
+    /// "voteWeighted".
+    event VoteWeighted(
+        address indexed voter,
+        uint64 indexed proposalId,
+        WeightedVoteOption[] options
+    );
+
+    /// @notice Submits a proposal with the caller as the proposer.
+    /// @param msgsJSON JSON array of the messages to execute if the proposal
+    /// passes, in the same format as the "messages" of a proposal file for
+    /// "nibid tx gov submit-proposal". Each message must have the "x/gov"
+    /// module account as its signer. Can be "[]" for a text proposal.
+    /// @param metadata Arbitrary metadata attached to the proposal
+    /// @param title Title of the proposal
+    /// @param summary Summary of the proposal
+    /// @param initialDeposit Coins deposited by the caller
+    /// @return proposalId ID of the new proposal
+    function submitProposal(
+        string memory msgsJSON,
+        string memory metadata,
+        string memory title,
+        string memory summary,
+        BankCoin[] memory initialDeposit
+    ) external returns (uint64 proposalId);
+
+    /// @notice Deposits coins from the caller to a proposal.
+    /// @param proposalId ID of the proposal
+    /// @param amount Coins to deposit
+    /// @return success True if the deposit succeeded
+    function deposit(
+        uint64 proposalId,
+        BankCoin[] memory amount
+    ) external returns (bool success);
+
+    /// @notice Casts a vote of the caller on a proposal.
+    /// @param proposalId ID of the proposal
+    /// @param option Vote option
+    /// @param metadata Arbitrary metadata attached to the vote
+    /// @return success True if the vote was cast
+    function vote(
+        uint64 proposalId,
+        uint8 option,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// @notice Casts a weighted vote of the caller on a proposal.
+    /// @param proposalId ID of the proposal
+    /// @param options Vote options with 18-decimal fixed point weights that
+    /// sum to 1e18
+    /// @param metadata Arbitrary metadata attached to the vote
+    /// @return success True if the vote was cast
+    function voteWeighted(
+        uint64 proposalId,
+        WeightedVoteOption[] memory options,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// @notice Queries a proposal by its ID.
+    /// @param proposalId ID of the proposal
+    /// @return info The proposal. Times are Unix times in seconds, or 0 if
+    /// unset.
+    function proposal(
+        uint64 proposalId
+    ) external view returns (Proposal memory info);
+
+    /// @notice Queries the tally of a proposal. For a proposal in its voting
+    /// period, this is the tally of the votes cast so far. Otherwise, it is
+    /// the final tally.
+    /// @param proposalId ID of the proposal
+    /// @return result Voting power for each option, in the bond denom
+    function tally(
+        uint64 proposalId
+    ) external view returns (TallyResult memory result);
+}
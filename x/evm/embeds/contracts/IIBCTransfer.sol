@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant IBC_TRANSFER_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
+
+IIBCTransfer constant IBC_TRANSFER_PRECOMPILE = IIBCTransfer(
+    IBC_TRANSFER_PRECOMPILE_ADDRESS
+);
+
+/// @notice IBC transfer interface for sending ICS-20 fungible token transfers
+/// from the EVM to other chains.
+/// @dev Transfers are only allowed over the channels listed in the
+/// "evm_channels" parameter of the EVM module.
+interface IIBCTransfer {
+    /// @notice Emitted when the caller sends an ICS-20 transfer with "transfer".
+    /// @param sender the caller of the precompile
+    /// @param channel the source channel of the transfer
+    /// @param token the bank denomination of the coins sent
+    /// @param amount the amount of coins sent
+    /// @param receiver the receiving address on the counterparty chain
+    /// @param sequence the sequence number of the IBC packet
+    event IBCTransfer(
+        address indexed sender,
+        string channel,
+        string token,
+        uint256 amount,
+        string receiver,
+        uint64 sequence
+    );
+
+    /// @notice Sends an ICS-20 transfer over the "transfer" port of the given
+    /// channel on behalf of the caller.
+    /// @param channel Source channel identifier, for example "channel-0". It
+    /// must be one of the EVM module's "evm_channels".
+    /// @param token Either the bank denom of the coin to transfer or the
+    /// hex address of an ERC20 token with a FunToken mapping. ERC20 tokens are
+    /// converted to their bank coin before the transfer.
+    /// @param amount Amount of the token to transfer
+    /// @param receiver Address of the recipient on the counterparty chain
+    /// @param timeoutTimestamp Absolute timeout of the packet as a Unix time in
+    /// nanoseconds on the counterparty chain
+    /// @return sequence Sequence number of the IBC packet that was sent
+    function transfer(
+        string memory channel,
+        string memory token,
+        uint256 amount,
+        string memory receiver,
+        uint64 timeoutTimestamp
+    ) external returns (uint64 sequence);
+}
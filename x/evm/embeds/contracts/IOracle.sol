@@ -3,6 +3,13 @@ pragma solidity >=0.8.19;
 
 /// @notice Oracle interface for querying exchange rates
 interface IOracle {
+    struct DatedExchangeRate {
+        string pair;
+        uint256 price;
+        uint64 blockTimeMs;
+        uint64 blockHeight;
+    }
+
     /// @notice Queries the dated exchange rate for a given pair
     /// @param pair The asset pair to query. For example, "ubtc:uusd" is the
     /// USD price of BTC and "unibi:uusd" is the USD price of NIBI.
@@ -17,6 +24,57 @@ interface IOracle {
         external
         view
         returns (uint256 price, uint64 blockTimeMs, uint64 blockHeight);
+
+    /// @notice Queries the time-weighted average price (TWAP) of a pair over
+    /// the TWAP lookback window of the oracle module.
+    /// @param pair The asset pair to query, e.g. "ubtc:uusd"
+    /// @return price The TWAP for the given pair with 18 decimals
+    /// @dev This function is view-only and does not modify state.
+    function queryExchangeRateTwap(
+        string memory pair
+    ) external view returns (uint256 price);
+
+    /// @notice Queries the dated exchange rates of every pair with a price.
+    /// @return rates The exchange rates, sorted by pair
+    /// @dev This function is view-only and does not modify state.
+    function queryExchangeRates()
+        external
+        view
+        returns (DatedExchangeRate[] memory rates);
+
+    /// @notice Queries all pairs for which an exchange rate exists.
+    /// @return pairs The active pairs, sorted
+    /// @dev This function is view-only and does not modify state.
+    function queryActivePairs() external view returns (string[] memory pairs);
+
+    /// @notice Number of decimals of the prices returned by
+    /// "latestRoundData", as in the Chainlink "AggregatorV3Interface".
+    function decimals() external view returns (uint8);
+
+    /// @notice Queries the latest price of a pair with the return values of
+    /// "latestRoundData" in the Chainlink "AggregatorV3Interface". Since the
+    /// pair is an argument, Chainlink consumers read the price of a pair
+    /// through a "NibiruOracleChainLinkLike" adapter deployed for it.
+    /// @param pair The asset pair to query, e.g. "ubtc:uusd"
+    /// @return roundId The block height when the price was last updated
+    /// @return answer The exchange rate for the given pair with "decimals()"
+    /// decimals
+    /// @return startedAt The block time in seconds when the price was last
+    /// updated
+    /// @return updatedAt Same as "startedAt"
+    /// @return answeredInRound Same as "roundId"
+    function latestRoundData(
+        string memory pair
+    )
+        external
+        view
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        );
 }
 
 address constant ORACLE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000801;
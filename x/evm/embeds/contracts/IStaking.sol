@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+IStaking constant STAKING_PRECOMPILE = IStaking(STAKING_PRECOMPILE_ADDRESS);
+
+/// @notice Staking interface for delegating NIBI to validators, managing
+/// existing delegations, and claiming staking rewards from the EVM.
+/// @dev All amounts are denominated in the smallest unit of the staking bond
+/// denom (unibi), not in wei. The caller of each mutating method is the
+/// delegator.
+interface IStaking {
+    struct BankCoin {
+        string denom;
+        uint256 amount;
+    }
+
+    struct Delegation {
+        string delegator;
+        string validator;
+        uint256 shares;
+        uint256 balance;
+    }
+
+    struct Validator {
+        string operatorAddress;
+        string moniker;
+        bool jailed;
+        string status;
+        uint256 tokens;
+        uint256 delegatorShares;
+        uint256 commissionRate;
+    }
+
+    /// @notice Emitted when the caller delegates with "delegate".
+    event Delegate(
+        address indexed delegator,
+        string validator,
+        uint256 amount
+    );
+
+    /// @notice Emitted when the caller undelegates with "undelegate".
+    event Undelegate(
+        address indexed delegator,
+        string validator,
+        uint256 amount,
+        int64 completionTime
+    );
+
+    /// @notice Emitted when the caller redelegates with "redelegate".
+    event Redelegate(
+        address indexed delegator,
+        string srcValidator,
+        string dstValidator,
+        uint256 amount,
+        int64 completionTime
+    );
+
+    /// @notice Emitted when the caller withdraws rewards with "withdrawRewards".
+    event WithdrawRewards(
+        address indexed delegator,
+        string validator,
+        BankCoin[] rewards
+    );
+
+    /// @notice Delegates tokens from the caller to a validator.
+    /// @param validatorAddr nibivaloper-prefixed Bech32 address of the validator
+    /// @param amount Amount of the bond denom to delegate
+    /// @return success True if the delegation succeeded
+    function delegate(
+        string memory validatorAddr,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @notice Begins unbonding tokens that the caller delegated to a validator.
+    /// @param validatorAddr nibivaloper-prefixed Bech32 address of the validator
+    /// @param amount Amount of the bond denom to undelegate
+    /// @return completionTime Unix time (seconds) when the unbonding completes
+    function undelegate(
+        string memory validatorAddr,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @notice Moves delegated tokens from one validator to another without
+    /// waiting for the unbonding period.
+    /// @param srcValidatorAddr nibivaloper-prefixed Bech32 address of the
+    /// validator currently holding the delegation
+    /// @param dstValidatorAddr nibivaloper-prefixed Bech32 address of the
+    /// validator receiving the delegation
+    /// @param amount Amount of the bond denom to redelegate
+    /// @return completionTime Unix time (seconds) when the redelegation completes
+    function redelegate(
+        string memory srcValidatorAddr,
+        string memory dstValidatorAddr,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @notice Withdraws the caller's staking rewards from a validator.
+    /// @param validatorAddr nibivaloper-prefixed Bech32 address of the validator
+    /// @return rewards Coins sent to the caller as rewards
+    function withdrawRewards(
+        string memory validatorAddr
+    ) external returns (BankCoin[] memory rewards);
+
+    /// @notice Queries the delegation of an account to a validator.
+    /// @param delegator Ethereum address of the delegator
+    /// @param validatorAddr nibivaloper-prefixed Bech32 address of the validator
+    /// @return info The delegation, including its share and token balance.
+    /// Shares are 18-decimal fixed point numbers.
+    function delegation(
+        address delegator,
+        string memory validatorAddr
+    ) external view returns (Delegation memory info);
+
+    /// @notice Queries a validator by its operator address.
+    /// @param validatorAddr nibivaloper-prefixed Bech32 address of the validator
+    /// @return info The validator. "delegatorShares" and "commissionRate"
+    /// are 18-decimal fixed point numbers.
+    function validator(
+        string memory validatorAddr
+    ) external view returns (Validator memory info);
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant TOKENFACTORY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+ITokenFactory constant TOKENFACTORY_PRECOMPILE = ITokenFactory(
+    TOKENFACTORY_PRECOMPILE_ADDRESS
+);
+
+/// @notice Token factory interface for creating and administering bank denoms
+/// from the EVM. Denoms created by a contract have the form
+/// "tf/{creator}/{subdenom}", where "creator" is the Bech32 (nibi) address of
+/// the contract, and the contract is their admin.
+/// @dev A typical token launch calls "createDenom", "setDenomMetadata",
+/// "createFunToken" and then "mint", all from the same contract.
+interface ITokenFactory {
+    struct DenomUnit {
+        string denom;
+        uint32 exponent;
+        string[] aliases;
+    }
+
+    struct DenomMetadata {
+        string description;
+        DenomUnit[] denomUnits;
+        string base;
+        string display;
+        string name;
+        string symbol;
+    }
+
+    /// @notice Emitted when the caller creates a denom with "createDenom".
+    event CreateDenom(address indexed creator, string denom);
+
+    /// @notice Emitted when the admin mints coins with "mint".
+    event Mint(
+        address indexed admin,
+        string denom,
+        uint256 amount,
+        string mintTo
+    );
+
+    /// @notice Emitted when the admin burns coins with "burn".
+    event Burn(
+        address indexed admin,
+        string denom,
+        uint256 amount,
+        string burnFrom
+    );
+
+    /// @notice Emitted when the admin transfers a denom with "changeAdmin".
+    event ChangeAdmin(address indexed admin, string denom, string newAdmin);
+
+    /// @notice Emitted when the admin sets metadata with "setDenomMetadata".
+    event SetDenomMetadata(address indexed admin, string denom);
+
+    /// @notice Emitted when the admin creates the FunToken mapping of a denom
+    /// with "createFunToken".
+    event CreateFunToken(
+        address indexed admin,
+        string denom,
+        address indexed erc20
+    );
+
+    /// @notice Creates a new denom with the caller as its creator and admin.
+    /// @param subdenom Subdenom of the new denom
+    /// @return denom Full denom of the form "tf/{creator}/{subdenom}"
+    function createDenom(
+        string memory subdenom
+    ) external returns (string memory denom);
+
+    /// @notice Mints coins of a denom administered by the caller.
+    /// @param denom Token factory denom
+    /// @param amount Amount of coins to mint
+    /// @param mintTo Recipient address as either a hex (0x) or Bech32 (nibi)
+    /// encoded string. Defaults to the caller if empty.
+    /// @return success True if the coins were minted
+    function mint(
+        string memory denom,
+        uint256 amount,
+        string memory mintTo
+    ) external returns (bool success);
+
+    /// @notice Burns coins of a denom administered by the caller.
+    /// @param denom Token factory denom
+    /// @param amount Amount of coins to burn
+    /// @param burnFrom Address to burn from as either a hex (0x) or Bech32
+    /// (nibi) encoded string. Defaults to the caller if empty.
+    /// @return success True if the coins were burned
+    function burn(
+        string memory denom,
+        uint256 amount,
+        string memory burnFrom
+    ) external returns (bool success);
+
+    /// @notice Transfers the admin role of a denom administered by the caller.
+    /// @param denom Token factory denom
+    /// @param newAdmin New admin as either a hex (0x) or Bech32 (nibi) encoded
+    /// string
+    /// @return success True if the admin was changed
+    function changeAdmin(
+        string memory denom,
+        string memory newAdmin
+    ) external returns (bool success);
+
+    /// @notice Sets the bank metadata of a denom administered by the caller.
+    /// @param metadata Bank metadata, where "metadata.base" is the denom
+    /// @return success True if the metadata was set
+    function setDenomMetadata(
+        DenomMetadata memory metadata
+    ) external returns (bool success);
+
+    /// @notice Registers a FunToken mapping for a denom administered by the
+    /// caller, deploying an ERC20 from its bank metadata. The
+    /// "create_funtoken_fee" of the EVM module is paid by the caller.
+    /// @param denom Token factory denom
+    /// @return erc20 Address of the ERC20 contract of the FunToken mapping
+    function createFunToken(
+        string memory denom
+    ) external returns (address erc20);
+
+    /// @notice Queries the admin of a token factory denom.
+    /// @param denom Token factory denom
+    /// @return admin Bech32 (nibi) address of the admin
+    function denomAdmin(
+        string memory denom
+    ) external view returns (string memory admin);
+}
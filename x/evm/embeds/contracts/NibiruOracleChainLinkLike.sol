@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+import "./IOracle.sol";
+
+/// @notice Price feed interface of Chainlink.
+interface AggregatorV3Interface {
+    function decimals() external view returns (uint8);
+
+    function description() external view returns (string memory);
+
+    function version() external view returns (uint256);
+
+    function getRoundData(
+        uint80 _roundId
+    )
+        external
+        view
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        );
+
+    function latestRoundData()
+        external
+        view
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        );
+}
+
+/// @title NibiruOracleChainLinkLike
+/// @notice Chainlink "AggregatorV3Interface" price feed of one pair of the
+/// Nibiru oracle. Contracts written for Chainlink price feeds can read Nibiru
+/// prices through an instance of this adapter deployed for the pair.
+/// @dev The adapter forwards its calls to the oracle precompile at 0x801.
+contract NibiruOracleChainLinkLike is AggregatorV3Interface {
+    /// @notice The asset pair of the price feed, e.g. "ubtc:uusd"
+    string private pair;
+
+    /// @param _pair The asset pair of the price feed, e.g. "ubtc:uusd"
+    constructor(string memory _pair) {
+        pair = _pair;
+    }
+
+    /// @notice Number of decimals of the answers of the price feed.
+    function decimals() external view override returns (uint8) {
+        return ORACLE_GATEWAY.decimals();
+    }
+
+    /// @notice The asset pair of the price feed, e.g. "ubtc:uusd"
+    function description() external view override returns (string memory) {
+        return pair;
+    }
+
+    /// @notice Version of the adapter.
+    function version() external pure override returns (uint256) {
+        return 1;
+    }
+
+    /// @notice Queries the price of the round "_roundId". The oracle only
+    /// keeps the latest price, whose round ID is the block height when it was
+    /// updated, so the call reverts for any other round.
+    /// @param _roundId The round ID to query
+    function getRoundData(
+        uint80 _roundId
+    )
+        external
+        view
+        override
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        )
+    {
+        (roundId, answer, startedAt, updatedAt, answeredInRound) = ORACLE_GATEWAY
+            .latestRoundData(pair);
+        require(roundId == _roundId, "No data present");
+    }
+
+    /// @notice Queries the latest price of the pair. See
+    /// "IOracle.latestRoundData" for the meaning of the return values.
+    function latestRoundData()
+        external
+        view
+        override
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        )
+    {
+        return ORACLE_GATEWAY.latestRoundData(pair);
+    }
+}
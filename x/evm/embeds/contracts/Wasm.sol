@@ -11,6 +11,27 @@ interface IWasm {
     uint256 amount;
   }
 
+  /// @notice Emitted for each Wasm contract executed with "execute" or
+  /// "executeMulti".
+  /// @param sender the caller of the precompile
+  /// @param contractAddr nibi-prefixed Bech32 address of the wasm contract
+  /// @param msgArgs JSON encoded wasm execute invocation
+  event WasmExecute(
+    address indexed sender,
+    string contractAddr,
+    bytes msgArgs
+  );
+
+  /// @notice Emitted when a Wasm contract is created with "instantiate".
+  /// @param sender the caller of the precompile
+  /// @param codeID the ID of the instantiated code
+  /// @param contractAddr nibi-prefixed Bech32 address of the new contract
+  event WasmInstantiate(
+    address indexed sender,
+    uint64 codeID,
+    string contractAddr
+  );
+
   /// @notice Invoke a contract's "ExecuteMsg", which corresponds to
   /// "wasm/types/MsgExecuteContract". This enables arbitrary smart contract
   /// execution using the Wasm VM from the EVM. 
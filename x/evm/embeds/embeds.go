@@ -19,10 +19,22 @@ var (
 	erc20MinterContractJSON []byte
 	//go:embed artifacts/contracts/IOracle.sol/IOracle.json
 	oracleContractJSON []byte
+	//go:embed artifacts/contracts/NibiruOracleChainLinkLike.sol/NibiruOracleChainLinkLike.json
+	oracleChainLinkLikeJSON []byte
 	//go:embed artifacts/contracts/IFunToken.sol/IFunToken.json
 	funtokenPrecompileJSON []byte
 	//go:embed artifacts/contracts/Wasm.sol/IWasm.json
 	wasmPrecompileJSON []byte
+	//go:embed artifacts/contracts/IStaking.sol/IStaking.json
+	stakingPrecompileJSON []byte
+	//go:embed artifacts/contracts/IIBCTransfer.sol/IIBCTransfer.json
+	ibcTransferPrecompileJSON []byte
+	//go:embed artifacts/contracts/IBank.sol/IBank.json
+	bankPrecompileJSON []byte
+	//go:embed artifacts/contracts/ITokenFactory.sol/ITokenFactory.json
+	tokenFactoryPrecompileJSON []byte
+	//go:embed artifacts/contracts/IGovernance.sol/IGovernance.json
+	governancePrecompileJSON []byte
 	//go:embed artifacts/contracts/TestERC20.sol/TestERC20.json
 	testErc20Json []byte
 	//go:embed artifacts/contracts/TestERC20MaliciousName.sol/TestERC20MaliciousName.json
@@ -66,6 +78,50 @@ var (
 		Name:      "Oracle.sol",
 		EmbedJSON: oracleContractJSON,
 	}
+	// SmartContract_NibiruOracleChainLinkLike: Chainlink
+	// "AggregatorV3Interface" price feed of one oracle pair, which forwards
+	// its calls to the oracle precompile. The pair is the constructor
+	// argument.
+	SmartContract_NibiruOracleChainLinkLike = CompiledEvmContract{
+		Name:      "NibiruOracleChainLinkLike.sol",
+		EmbedJSON: oracleChainLinkLikeJSON,
+	}
+	// SmartContract_Staking: Precompile contract interface for
+	// "IStaking.sol". This precompile enables delegations, undelegations,
+	// redelegations, and reward withdrawals from EVM accounts. Only the ABI is
+	// used.
+	SmartContract_Staking = CompiledEvmContract{
+		Name:      "IStaking.sol",
+		EmbedJSON: stakingPrecompileJSON,
+	}
+	// SmartContract_IBCTransfer: Precompile contract interface for
+	// "IIBCTransfer.sol". This precompile enables ICS-20 transfers of bank coins
+	// and FunToken-mapped ERC20s from EVM accounts. Only the ABI is used.
+	SmartContract_IBCTransfer = CompiledEvmContract{
+		Name:      "IIBCTransfer.sol",
+		EmbedJSON: ibcTransferPrecompileJSON,
+	}
+	// SmartContract_Bank: Precompile contract interface for "IBank.sol". This
+	// precompile enables transfers and queries of native bank coins from EVM
+	// accounts. Only the ABI is used.
+	SmartContract_Bank = CompiledEvmContract{
+		Name:      "IBank.sol",
+		EmbedJSON: bankPrecompileJSON,
+	}
+	// SmartContract_TokenFactory: Precompile contract interface for
+	// "ITokenFactory.sol". This precompile enables EVM accounts to create and
+	// administer token factory denoms. Only the ABI is used.
+	SmartContract_TokenFactory = CompiledEvmContract{
+		Name:      "ITokenFactory.sol",
+		EmbedJSON: tokenFactoryPrecompileJSON,
+	}
+	// SmartContract_Governance: Precompile contract interface for
+	// "IGovernance.sol". This precompile enables EVM accounts to submit
+	// proposals, deposit, and vote in "x/gov". Only the ABI is used.
+	SmartContract_Governance = CompiledEvmContract{
+		Name:      "IGovernance.sol",
+		EmbedJSON: governancePrecompileJSON,
+	}
 	SmartContract_TestERC20 = CompiledEvmContract{
 		Name:      "TestERC20.sol",
 		EmbedJSON: testErc20Json,
@@ -125,6 +181,12 @@ func init() {
 	SmartContract_FunToken.MustLoad()
 	SmartContract_Wasm.MustLoad()
 	SmartContract_Oracle.MustLoad()
+	SmartContract_NibiruOracleChainLinkLike.MustLoad()
+	SmartContract_Staking.MustLoad()
+	SmartContract_IBCTransfer.MustLoad()
+	SmartContract_Bank.MustLoad()
+	SmartContract_TokenFactory.MustLoad()
+	SmartContract_Governance.MustLoad()
 	SmartContract_TestERC20.MustLoad()
 	SmartContract_TestERC20MaliciousName.MustLoad()
 	SmartContract_TestERC20MaliciousTransfer.MustLoad()
@@ -13,6 +13,12 @@ func TestLoadContracts(t *testing.T) {
 	require.NotPanics(t, func() {
 		embeds.SmartContract_ERC20Minter.MustLoad()
 		embeds.SmartContract_FunToken.MustLoad()
+		embeds.SmartContract_Staking.MustLoad()
+		embeds.SmartContract_IBCTransfer.MustLoad()
+		embeds.SmartContract_Bank.MustLoad()
+		embeds.SmartContract_TokenFactory.MustLoad()
+		embeds.SmartContract_Governance.MustLoad()
+		embeds.SmartContract_NibiruOracleChainLinkLike.MustLoad()
 		embeds.SmartContract_TestERC20.MustLoad()
 		embeds.SmartContract_TestERC20MaliciousName.MustLoad()
 		embeds.SmartContract_TestERC20MaliciousTransfer.MustLoad()
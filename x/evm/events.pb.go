@@ -354,6 +354,76 @@ func (m *EventConvertCoinToEvm) GetBankCoin() types.Coin {
 	return types.Coin{}
 }
 
+// EventConvertEvmToCoin defines the event for sending ERC20 tokens of a fun
+// token to its bank coin representation.
+type EventConvertEvmToCoin struct {
+	Sender               string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string     `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	ToBankAddr           string     `protobuf:"bytes,3,opt,name=to_bank_addr,json=toBankAddr,proto3" json:"to_bank_addr,omitempty"`
+	BankCoin             types.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+}
+
+func (m *EventConvertEvmToCoin) Reset()         { *m = EventConvertEvmToCoin{} }
+func (m *EventConvertEvmToCoin) String() string { return proto.CompactTextString(m) }
+func (*EventConvertEvmToCoin) ProtoMessage()    {}
+func (*EventConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{5}
+}
+func (m *EventConvertEvmToCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertEvmToCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertEvmToCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertEvmToCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertEvmToCoin.Merge(m, src)
+}
+func (m *EventConvertEvmToCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertEvmToCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertEvmToCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertEvmToCoin proto.InternalMessageInfo
+
+func (m *EventConvertEvmToCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetErc20ContractAddress() string {
+	if m != nil {
+		return m.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetToBankAddr() string {
+	if m != nil {
+		return m.ToBankAddr
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetBankCoin() types.Coin {
+	if m != nil {
+		return m.BankCoin
+	}
+	return types.Coin{}
+}
+
 // EventTransfer defines event for EVM transfer
 type EventTransfer struct {
 	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -365,7 +435,7 @@ func (m *EventTransfer) Reset()         { *m = EventTransfer{} }
 func (m *EventTransfer) String() string { return proto.CompactTextString(m) }
 func (*EventTransfer) ProtoMessage()    {}
 func (*EventTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{5}
+	return fileDescriptor_f8bc26b53c788f17, []int{6}
 }
 func (m *EventTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +495,7 @@ func (m *EventContractDeployed) Reset()         { *m = EventContractDeployed{} }
 func (m *EventContractDeployed) String() string { return proto.CompactTextString(m) }
 func (*EventContractDeployed) ProtoMessage()    {}
 func (*EventContractDeployed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{6}
+	return fileDescriptor_f8bc26b53c788f17, []int{7}
 }
 func (m *EventContractDeployed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +548,7 @@ func (m *EventContractExecuted) Reset()         { *m = EventContractExecuted{} }
 func (m *EventContractExecuted) String() string { return proto.CompactTextString(m) }
 func (*EventContractExecuted) ProtoMessage()    {}
 func (*EventContractExecuted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{7}
+	return fileDescriptor_f8bc26b53c788f17, []int{8}
 }
 func (m *EventContractExecuted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,6 +597,7 @@ func init() {
 	proto.RegisterType((*EventBlockBloom)(nil), "eth.evm.v1.EventBlockBloom")
 	proto.RegisterType((*EventFunTokenCreated)(nil), "eth.evm.v1.EventFunTokenCreated")
 	proto.RegisterType((*EventConvertCoinToEvm)(nil), "eth.evm.v1.EventConvertCoinToEvm")
+	proto.RegisterType((*EventConvertEvmToCoin)(nil), "eth.evm.v1.EventConvertEvmToCoin")
 	proto.RegisterType((*EventTransfer)(nil), "eth.evm.v1.EventTransfer")
 	proto.RegisterType((*EventContractDeployed)(nil), "eth.evm.v1.EventContractDeployed")
 	proto.RegisterType((*EventContractExecuted)(nil), "eth.evm.v1.EventContractExecuted")
@@ -535,46 +606,48 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/events.proto", fileDescriptor_f8bc26b53c788f17) }
 
 var fileDescriptor_f8bc26b53c788f17 = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4b, 0x6e, 0xd4, 0x40,
-	0x10, 0x1d, 0xe7, 0x37, 0x99, 0x0e, 0xe1, 0xd3, 0x1a, 0x12, 0x27, 0x02, 0x27, 0x32, 0xe2, 0xb7,
-	0xb1, 0x99, 0xc0, 0x8a, 0x15, 0xcc, 0x64, 0x22, 0x16, 0x80, 0x50, 0x34, 0x6c, 0x90, 0x90, 0xd5,
-	0xb6, 0x2b, 0xb6, 0x15, 0xbb, 0x2b, 0xea, 0x6e, 0x5b, 0xce, 0x2d, 0x38, 0x0a, 0xc7, 0x88, 0xc4,
-	0x26, 0x3b, 0x58, 0x45, 0x28, 0xb9, 0x01, 0x27, 0x40, 0xdd, 0x76, 0x32, 0x09, 0x28, 0x1b, 0xd8,
-	0x55, 0xbd, 0xfa, 0x74, 0xbd, 0xaa, 0x67, 0x93, 0x55, 0x50, 0xa9, 0x0f, 0x55, 0xe1, 0x57, 0x03,
-	0x1f, 0x2a, 0xe0, 0x4a, 0x7a, 0x07, 0x02, 0x15, 0x52, 0x02, 0x2a, 0xf5, 0xa0, 0x2a, 0xbc, 0x6a,
-	0xb0, 0xee, 0x44, 0x28, 0x0b, 0x94, 0x7e, 0xc8, 0x24, 0xf8, 0xd5, 0x20, 0x04, 0xc5, 0x06, 0x7e,
-	0x84, 0x19, 0x6f, 0x72, 0xd7, 0xfb, 0x09, 0x26, 0x68, 0x4c, 0x5f, 0x5b, 0x0d, 0xea, 0x7e, 0xb3,
-	0xc8, 0xad, 0xb1, 0x6e, 0x39, 0x56, 0x29, 0x08, 0x28, 0x8b, 0x49, 0x4d, 0x57, 0xc8, 0x02, 0x2b,
-	0xb0, 0xe4, 0xca, 0xb6, 0x36, 0xad, 0x27, 0xbd, 0xdd, 0xd6, 0xa3, 0x6b, 0x64, 0x11, 0x54, 0x1a,
-	0xa4, 0x4c, 0xa6, 0xf6, 0x8c, 0x89, 0x74, 0x41, 0xa5, 0x6f, 0x98, 0x4c, 0x69, 0x9f, 0xcc, 0x67,
-	0x3c, 0x86, 0xda, 0x9e, 0x35, 0x78, 0xe3, 0xe8, 0x82, 0x84, 0xc9, 0xa0, 0x94, 0x10, 0xdb, 0x73,
-	0x4d, 0x41, 0xc2, 0xe4, 0x47, 0x09, 0x31, 0xa5, 0x64, 0xce, 0xf4, 0x99, 0x37, 0xb0, 0xb1, 0xe9,
-	0x3d, 0xd2, 0x13, 0x10, 0x65, 0x07, 0x19, 0x70, 0x65, 0x2f, 0x98, 0xc0, 0x14, 0xa0, 0x2e, 0x59,
-	0xd6, 0xaf, 0xab, 0x3a, 0xd8, 0x63, 0x59, 0x0e, 0xb1, 0xdd, 0x35, 0x19, 0x4b, 0xa0, 0xd2, 0x49,
-	0xbd, 0x63, 0x20, 0xf7, 0x21, 0x21, 0x86, 0xcc, 0xa4, 0x7e, 0x8b, 0x09, 0x5d, 0x25, 0x5d, 0x55,
-	0x07, 0x39, 0x26, 0xd2, 0xb6, 0x36, 0x67, 0x35, 0x11, 0xa5, 0x71, 0xe9, 0x3e, 0x6e, 0x39, 0x0f,
-	0x73, 0x8c, 0xf6, 0x87, 0x39, 0x62, 0xa1, 0x09, 0x84, 0xda, 0x68, 0x29, 0x37, 0x8e, 0xfb, 0xd5,
-	0x22, 0x7d, 0x93, 0xb9, 0x53, 0xf2, 0x09, 0xee, 0x03, 0x1f, 0x09, 0x60, 0x0a, 0x62, 0x7a, 0x9f,
-	0x90, 0x90, 0xf1, 0xfd, 0x20, 0x06, 0x7e, 0x51, 0xd3, 0xd3, 0xc8, 0xb6, 0x06, 0xe8, 0x0b, 0xb2,
-	0x02, 0x22, 0xda, 0x7a, 0x16, 0x44, 0xc8, 0x95, 0x60, 0x91, 0x0a, 0x58, 0x1c, 0x0b, 0x90, 0xb2,
-	0xdd, 0x5b, 0xdf, 0x44, 0x47, 0x6d, 0xf0, 0x75, 0x13, 0xa3, 0x36, 0xe9, 0x46, 0xba, 0x3f, 0x8a,
-	0x76, 0x8d, 0xe7, 0x2e, 0x7d, 0x4a, 0xee, 0x64, 0x32, 0x28, 0x58, 0x0c, 0xc1, 0x9e, 0xc0, 0x22,
-	0xd0, 0x67, 0x35, 0x1b, 0x5d, 0xdc, 0xbd, 0x99, 0xc9, 0x77, 0x2c, 0x86, 0x1d, 0x81, 0xc5, 0x08,
-	0x33, 0xee, 0x7e, 0xb7, 0xc8, 0x5d, 0x33, 0xf2, 0x08, 0x79, 0x05, 0x42, 0x69, 0x70, 0x82, 0xe3,
-	0xaa, 0xd0, 0x67, 0x95, 0xc0, 0x63, 0x10, 0xe7, 0x67, 0x6d, 0xbc, 0x7f, 0x1c, 0xd6, 0x21, 0x4b,
-	0x0a, 0x03, 0x7d, 0x11, 0x9d, 0xdd, 0x0e, 0xdc, 0x53, 0x38, 0x56, 0xa9, 0x4e, 0xa1, 0x1f, 0x88,
-	0xd9, 0xc7, 0x74, 0xd4, 0xa5, 0xad, 0x35, 0xaf, 0x91, 0xa8, 0xa7, 0x25, 0xea, 0xb5, 0x12, 0xf5,
-	0xf4, 0x80, 0x43, 0xfb, 0xe8, 0x64, 0xa3, 0xf3, 0xeb, 0x64, 0xe3, 0xf6, 0x21, 0x2b, 0xf2, 0x97,
-	0xee, 0x45, 0xa5, 0xbb, 0xbb, 0xa8, 0x6d, 0xc3, 0xec, 0x33, 0x59, 0x6e, 0x8e, 0x2b, 0x18, 0x97,
-	0x7b, 0x20, 0xae, 0x25, 0x74, 0x45, 0x47, 0x33, 0x7f, 0xea, 0x68, 0xaa, 0xee, 0xd9, 0xcb, 0xea,
-	0x76, 0x27, 0xd3, 0xbd, 0x19, 0xa2, 0xdb, 0x70, 0x90, 0xe3, 0x21, 0xc4, 0xd7, 0x3e, 0xf3, 0x80,
-	0x2c, 0x5f, 0xd9, 0x58, 0xfb, 0xd4, 0x8d, 0xe8, 0xd2, 0xa6, 0xfe, 0xea, 0x3a, 0xae, 0x21, 0x2a,
-	0xd5, 0x7f, 0x76, 0x1d, 0xbe, 0x3a, 0x3a, 0x75, 0xac, 0xe3, 0x53, 0xc7, 0xfa, 0x79, 0xea, 0x58,
-	0x5f, 0xce, 0x9c, 0xce, 0xf1, 0x99, 0xd3, 0xf9, 0x71, 0xe6, 0x74, 0x3e, 0x3d, 0x4a, 0x32, 0x95,
-	0x96, 0xa1, 0x17, 0x61, 0xe1, 0xbf, 0xcf, 0xc2, 0x4c, 0x94, 0xa3, 0x94, 0x65, 0xdc, 0xe7, 0xc6,
-	0xf6, 0xab, 0x2d, 0xbf, 0xd6, 0x7f, 0x92, 0x70, 0xc1, 0x7c, 0xfe, 0xcf, 0x7f, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x1c, 0xa0, 0xad, 0x46, 0x5b, 0x04, 0x00, 0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc9, 0x4e, 0xdc, 0x4c,
+	0x10, 0x1e, 0xb3, 0x0d, 0xd3, 0xc0, 0xbf, 0xb4, 0xe6, 0x07, 0x83, 0xfe, 0x18, 0xe4, 0x28, 0xdb,
+	0xc5, 0xce, 0x90, 0x9c, 0x72, 0x4a, 0x66, 0x18, 0x94, 0x43, 0x12, 0x45, 0x68, 0x72, 0x89, 0x14,
+	0x59, 0x6d, 0xbb, 0xb0, 0xad, 0x19, 0x77, 0xa1, 0xee, 0xb6, 0x65, 0xde, 0x22, 0x8f, 0x92, 0xc7,
+	0x40, 0xca, 0x85, 0x5b, 0x92, 0x0b, 0x8a, 0xe0, 0x0d, 0xf2, 0x04, 0x51, 0xb7, 0x0d, 0x03, 0x44,
+	0x5c, 0xb2, 0xdc, 0xaa, 0xbe, 0x5a, 0xba, 0xbe, 0x5a, 0x9a, 0xac, 0x81, 0x4a, 0x7d, 0x28, 0x73,
+	0xbf, 0xec, 0xf9, 0x50, 0x02, 0x57, 0xd2, 0x3b, 0x10, 0xa8, 0x90, 0x12, 0x50, 0xa9, 0x07, 0x65,
+	0xee, 0x95, 0xbd, 0x0d, 0x27, 0x42, 0x99, 0xa3, 0xf4, 0x43, 0x26, 0xc1, 0x2f, 0x7b, 0x21, 0x28,
+	0xd6, 0xf3, 0x23, 0xcc, 0x78, 0xed, 0xbb, 0xd1, 0x4d, 0x30, 0x41, 0x23, 0xfa, 0x5a, 0xaa, 0x51,
+	0xf7, 0xa3, 0x45, 0xfe, 0x1e, 0xea, 0x94, 0x43, 0x95, 0x82, 0x80, 0x22, 0x1f, 0x55, 0x74, 0x95,
+	0x2c, 0xb0, 0x1c, 0x0b, 0xae, 0x6c, 0x6b, 0xcb, 0xba, 0xdf, 0xd9, 0x6b, 0x34, 0xba, 0x4e, 0x16,
+	0x41, 0xa5, 0x41, 0xca, 0x64, 0x6a, 0xcf, 0x18, 0x4b, 0x1b, 0x54, 0xfa, 0x9c, 0xc9, 0x94, 0x76,
+	0xc9, 0x7c, 0xc6, 0x63, 0xa8, 0xec, 0x59, 0x83, 0xd7, 0x8a, 0x0e, 0x48, 0x98, 0x0c, 0x0a, 0x09,
+	0xb1, 0x3d, 0x57, 0x07, 0x24, 0x4c, 0xbe, 0x91, 0x10, 0x53, 0x4a, 0xe6, 0x4c, 0x9e, 0x79, 0x03,
+	0x1b, 0x99, 0xfe, 0x4f, 0x3a, 0x02, 0xa2, 0xec, 0x20, 0x03, 0xae, 0xec, 0x05, 0x63, 0x98, 0x02,
+	0xd4, 0x25, 0x2b, 0xfa, 0x75, 0x55, 0x05, 0xfb, 0x2c, 0x9b, 0x40, 0x6c, 0xb7, 0x8d, 0xc7, 0x12,
+	0xa8, 0x74, 0x54, 0xed, 0x1a, 0xc8, 0xbd, 0x43, 0x88, 0x21, 0x33, 0xaa, 0x5e, 0x60, 0x42, 0xd7,
+	0x48, 0x5b, 0x55, 0xc1, 0x04, 0x13, 0x69, 0x5b, 0x5b, 0xb3, 0x9a, 0x88, 0xd2, 0xb8, 0x74, 0xef,
+	0x35, 0x9c, 0xfb, 0x13, 0x8c, 0xc6, 0xfd, 0x09, 0x62, 0xae, 0x09, 0x84, 0x5a, 0x68, 0x28, 0xd7,
+	0x8a, 0xfb, 0xc1, 0x22, 0x5d, 0xe3, 0xb9, 0x5b, 0xf0, 0x11, 0x8e, 0x81, 0x0f, 0x04, 0x30, 0x05,
+	0x31, 0xbd, 0x45, 0x48, 0xc8, 0xf8, 0x38, 0x88, 0x81, 0x5f, 0xc4, 0x74, 0x34, 0xb2, 0xa3, 0x01,
+	0xfa, 0x98, 0xac, 0x82, 0x88, 0xb6, 0x1f, 0x06, 0x11, 0x72, 0x25, 0x58, 0xa4, 0x02, 0x16, 0xc7,
+	0x02, 0xa4, 0x6c, 0xfa, 0xd6, 0x35, 0xd6, 0x41, 0x63, 0x7c, 0x56, 0xdb, 0xa8, 0x4d, 0xda, 0x91,
+	0xce, 0x8f, 0xa2, 0x69, 0xe3, 0xb9, 0x4a, 0x1f, 0x90, 0x7f, 0x33, 0x19, 0xe4, 0x2c, 0x86, 0x60,
+	0x5f, 0x60, 0x1e, 0xe8, 0xb1, 0x9a, 0x8e, 0x2e, 0xee, 0xfd, 0x95, 0xc9, 0x97, 0x2c, 0x86, 0x5d,
+	0x81, 0xf9, 0x00, 0x33, 0xee, 0x7e, 0xb2, 0xc8, 0x7f, 0xa6, 0xe4, 0x01, 0xf2, 0x12, 0x84, 0xd2,
+	0xe0, 0x08, 0x87, 0x65, 0xae, 0xc7, 0x2a, 0x81, 0xc7, 0x20, 0xce, 0xc7, 0x5a, 0x6b, 0x3f, 0x59,
+	0xac, 0x43, 0x96, 0x14, 0x06, 0x7a, 0x22, 0xda, 0xbb, 0x29, 0xb8, 0xa3, 0x70, 0xa8, 0x52, 0xed,
+	0x42, 0x5f, 0x13, 0xd3, 0x8f, 0x69, 0xa9, 0x4b, 0xdb, 0xeb, 0x5e, 0xbd, 0xa2, 0x9e, 0x5e, 0x51,
+	0xaf, 0x59, 0x51, 0x4f, 0x17, 0xd8, 0xb7, 0x8f, 0x4e, 0x36, 0x5b, 0xdf, 0x4e, 0x36, 0xff, 0x39,
+	0x64, 0xf9, 0xe4, 0x89, 0x7b, 0x11, 0xe9, 0xee, 0x2d, 0x6a, 0xd9, 0x30, 0xfb, 0x72, 0x8d, 0xd9,
+	0xb0, 0xcc, 0x47, 0xa8, 0x2d, 0xbf, 0x99, 0xd9, 0x16, 0x59, 0x56, 0x18, 0x98, 0x12, 0x2e, 0x51,
+	0x23, 0x0a, 0xfb, 0x8c, 0x8f, 0xff, 0x10, 0xb7, 0x77, 0x64, 0xa5, 0x5e, 0x5c, 0xc1, 0xb8, 0xdc,
+	0x07, 0x71, 0x23, 0xa5, 0x2b, 0x37, 0x32, 0x73, 0xfd, 0x46, 0xa6, 0x97, 0x3b, 0x7b, 0xf9, 0x72,
+	0xdd, 0xd1, 0xb4, 0x73, 0x86, 0xea, 0x0e, 0x1c, 0x4c, 0xf0, 0x10, 0xe2, 0x1b, 0x9f, 0xb9, 0x4d,
+	0x56, 0xae, 0xf4, 0xac, 0x79, 0x6a, 0x39, 0xba, 0xd4, 0xab, 0x1f, 0xb2, 0x0e, 0x2b, 0x88, 0x0a,
+	0xf5, 0x8b, 0x59, 0xfb, 0x4f, 0x8f, 0x4e, 0x1d, 0xeb, 0xf8, 0xd4, 0xb1, 0xbe, 0x9e, 0x3a, 0xd6,
+	0xfb, 0x33, 0xa7, 0x75, 0x7c, 0xe6, 0xb4, 0x3e, 0x9f, 0x39, 0xad, 0xb7, 0x77, 0x93, 0x4c, 0xa5,
+	0x45, 0xe8, 0x45, 0x98, 0xfb, 0xaf, 0xb2, 0x30, 0x13, 0xc5, 0x20, 0x65, 0x19, 0xf7, 0xb9, 0x91,
+	0xfd, 0x72, 0xdb, 0xaf, 0xf4, 0x2f, 0x19, 0x2e, 0x98, 0xaf, 0xed, 0xd1, 0xf7, 0x01, 0x00, 0x6e,
+	0x9a, 0x6a, 0x3b, 0x37, 0x05, 0x00, 0x00,
 }
 
 func (m *EventEthereumTx) Marshal() (dAtA []byte, err error) {
@@ -819,6 +892,60 @@ func (m *EventConvertCoinToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventConvertEvmToCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConvertEvmToCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToBankAddr) > 0 {
+		i -= len(m.ToBankAddr)
+		copy(dAtA[i:], m.ToBankAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToBankAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20ContractAddress) > 0 {
+		i -= len(m.Erc20ContractAddress)
+		copy(dAtA[i:], m.Erc20ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1060,6 +1187,29 @@ func (m *EventConvertCoinToEvm) Size() (n int) {
 	return n
 }
 
+func (m *EventConvertEvmToCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Erc20ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToBankAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BankCoin.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func (m *EventTransfer) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1904,6 +2054,185 @@ func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventConvertEvmToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConvertEvmToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBankAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToBankAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -96,6 +96,17 @@ type Params struct {
 	// Fee deducted and burned when calling "CreateFunToken" in units of
 	// "evm_denom".
 	CreateFuntokenFee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=create_funtoken_fee,json=createFuntokenFee,proto3,customtype=cosmossdk.io/math.Int" json:"create_funtoken_fee"`
+	// base_fee_change_denominator bounds the amount the EIP-1559 base fee can
+	// change between blocks. The base fee changes by at most
+	// 1/base_fee_change_denominator per block. A value of 0 disables the dynamic
+	// base fee, fixing it at "min_base_fee".
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,10,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// base_fee_elasticity_multiplier is the ratio between the block gas limit
+	// and the gas target of a block. Blocks in which Ethereum txs use more gas
+	// than the target raise the base fee, and blocks that use less lower it.
+	BaseFeeElasticityMultiplier uint32 `protobuf:"varint,11,opt,name=base_fee_elasticity_multiplier,json=baseFeeElasticityMultiplier,proto3" json:"base_fee_elasticity_multiplier,omitempty"`
+	// min_base_fee is the floor of the base fee in units of "evm_denom" per gas.
+	MinBaseFee cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_base_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -145,6 +156,20 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.BaseFeeElasticityMultiplier
+	}
+	return 0
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	// key is the stored key
@@ -663,77 +688,82 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/evm.proto", fileDescriptor_98abbdadb327b7d0) }
 
 var fileDescriptor_98abbdadb327b7d0 = []byte{
-	// 1113 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x31, 0x6f, 0xdb, 0x46,
-	0x14, 0xb6, 0x2c, 0x4a, 0xa2, 0x4e, 0x72, 0xc4, 0x5c, 0xdc, 0x94, 0x4d, 0x10, 0xd3, 0x60, 0x81,
-	0xc2, 0x05, 0x02, 0xa9, 0x71, 0x90, 0x0e, 0xe9, 0x52, 0xcb, 0xb1, 0x51, 0xab, 0x76, 0x1a, 0x5c,
-	0x9c, 0x0e, 0x5d, 0x88, 0x13, 0xf9, 0x4c, 0x11, 0x22, 0x79, 0xc2, 0xdd, 0x51, 0x90, 0x7e, 0x40,
-	0x81, 0x8e, 0xfd, 0x09, 0xd9, 0xfb, 0x47, 0x82, 0x4e, 0x19, 0x8b, 0x0e, 0x44, 0xe1, 0x2c, 0x85,
-	0x46, 0x4f, 0x45, 0xa7, 0xe2, 0x8e, 0x94, 0x25, 0xbb, 0x40, 0x3b, 0xe9, 0x7d, 0xdf, 0xbb, 0xf7,
-	0xde, 0xf1, 0x7b, 0x1f, 0x45, 0xb4, 0x0d, 0x72, 0xd4, 0x83, 0x69, 0xd2, 0x9b, 0x3e, 0x51, 0x3f,
-	0xdd, 0x09, 0x67, 0x92, 0x61, 0x04, 0x72, 0xd4, 0x55, 0x70, 0xfa, 0xe4, 0xc1, 0x76, 0xc8, 0x42,
-	0xa6, 0xe9, 0x9e, 0x8a, 0x8a, 0x13, 0xee, 0x2f, 0x15, 0x64, 0x1e, 0x67, 0xe9, 0x39, 0x1b, 0x43,
-	0x8a, 0xdf, 0x20, 0x04, 0xdc, 0xdf, 0xff, 0xc2, 0xa3, 0x41, 0xc0, 0xed, 0xca, 0x6e, 0x65, 0xaf,
-	0xd9, 0xff, 0xf2, 0x5d, 0xee, 0x6c, 0xfc, 0x9e, 0x3b, 0xdd, 0x30, 0x92, 0xa3, 0x6c, 0xd8, 0xf5,
-	0x59, 0xd2, 0x7b, 0x19, 0x0d, 0x23, 0x9e, 0x1d, 0x8e, 0x68, 0x94, 0xf6, 0x52, 0x1d, 0xf7, 0xa6,
-	0xfb, 0x3d, 0x35, 0xeb, 0xe8, 0xe4, 0xd5, 0xb3, 0x67, 0x07, 0x41, 0xc0, 0x49, 0x53, 0x77, 0x52,
-	0x21, 0x7e, 0x84, 0xd0, 0x90, 0xa6, 0x63, 0x2f, 0x80, 0x94, 0x25, 0xf6, 0xa6, 0x6a, 0x4b, 0x9a,
-	0x8a, 0x79, 0xa1, 0x08, 0xfc, 0x39, 0xba, 0x1b, 0x09, 0x2f, 0xa1, 0x01, 0x78, 0x17, 0x9c, 0x25,
-	0x9e, 0xcf, 0xa2, 0xd4, 0xae, 0xee, 0x56, 0xf6, 0x4c, 0x72, 0x27, 0x12, 0x67, 0x34, 0x80, 0x63,
-	0xce, 0x92, 0x43, 0x16, 0xa5, 0xee, 0x5f, 0x15, 0x54, 0x7f, 0x45, 0x39, 0x4d, 0x04, 0x3e, 0x40,
-	0x08, 0x66, 0x92, 0x53, 0x0f, 0xa2, 0x89, 0xb0, 0x8d, 0xdd, 0xea, 0x5e, 0xb5, 0xef, 0x5e, 0xe6,
-	0x4e, 0xf3, 0x48, 0xb1, 0x47, 0x27, 0xaf, 0xc4, 0x55, 0xee, 0xdc, 0x9d, 0xd3, 0x24, 0x7e, 0xee,
-	0xae, 0x0e, 0xba, 0xa4, 0xa9, 0xc1, 0x51, 0x34, 0x11, 0x78, 0x1f, 0xb5, 0x61, 0x9a, 0x78, 0xfe,
-	0x88, 0xa6, 0x29, 0xc4, 0xc2, 0x36, 0x77, 0xab, 0x7b, 0xcd, 0x7e, 0xe7, 0x32, 0x77, 0x5a, 0x47,
-	0xdf, 0x9f, 0x1d, 0x96, 0x34, 0x69, 0xc1, 0x34, 0x59, 0x02, 0x7c, 0x86, 0xee, 0xf9, 0x1c, 0xa8,
-	0x04, 0xef, 0x22, 0x4b, 0xa5, 0x52, 0xcd, 0xbb, 0x00, 0xb0, 0x9b, 0x5a, 0xab, 0x47, 0xa5, 0x56,
-	0x1f, 0xf9, 0x4c, 0x24, 0x4c, 0x88, 0x60, 0xdc, 0x8d, 0x58, 0x2f, 0xa1, 0x72, 0xd4, 0x3d, 0x49,
-	0x25, 0xb9, 0x5b, 0x54, 0x1e, 0x97, 0x85, 0xc7, 0x00, 0xcf, 0x8d, 0x3f, 0xdf, 0x3a, 0x95, 0x81,
-	0x61, 0x56, 0xac, 0xcd, 0x81, 0x61, 0x6e, 0x5a, 0xd5, 0x81, 0x61, 0x56, 0x2d, 0x63, 0x60, 0x98,
-	0x35, 0xab, 0x3e, 0x30, 0xcc, 0xba, 0xd5, 0x18, 0x18, 0x66, 0xc3, 0x32, 0xdd, 0x1e, 0xaa, 0xbd,
-	0x96, 0x54, 0x02, 0xb6, 0x50, 0x75, 0x0c, 0xf3, 0x62, 0x3b, 0x44, 0x85, 0x78, 0x1b, 0xd5, 0xa6,
-	0x34, 0xce, 0xa0, 0x94, 0xb6, 0x00, 0xee, 0x00, 0x75, 0xce, 0x39, 0x4d, 0x05, 0xf5, 0x65, 0xc4,
-	0xd2, 0x53, 0x16, 0x0a, 0x8c, 0x91, 0x31, 0xa2, 0x62, 0x54, 0xd6, 0xea, 0x18, 0x7f, 0x8a, 0x8c,
-	0x98, 0x85, 0xc2, 0xde, 0xdc, 0xad, 0xee, 0xb5, 0xf6, 0x3b, 0xdd, 0x95, 0x63, 0xba, 0xa7, 0x2c,
-	0x24, 0x3a, 0xe9, 0xfe, 0xba, 0x89, 0xaa, 0xa7, 0x2c, 0xc4, 0x36, 0x6a, 0x28, 0x6b, 0x80, 0x10,
-	0x65, 0x8f, 0x25, 0xc4, 0xf7, 0x51, 0x5d, 0xb2, 0x49, 0xe4, 0x17, 0x8d, 0x9a, 0xa4, 0x44, 0x6a,
-	0x64, 0x40, 0x25, 0xd5, 0xfb, 0x6c, 0x13, 0x1d, 0x2b, 0xdd, 0x87, 0x31, 0xf3, 0xc7, 0x5e, 0x9a,
-	0x25, 0x43, 0xe0, 0xb6, 0xb1, 0x5b, 0xd9, 0x33, 0xfa, 0x9d, 0x45, 0xee, 0xb4, 0x34, 0xff, 0x52,
-	0xd3, 0x64, 0x1d, 0xe0, 0xc7, 0xa8, 0x21, 0x67, 0x9e, 0xbe, 0x7d, 0x4d, 0x6b, 0x7d, 0x6f, 0x91,
-	0x3b, 0x1d, 0xb9, 0x7a, 0xc0, 0x6f, 0xa8, 0x18, 0x91, 0xba, 0x9c, 0xa9, 0x5f, 0xdc, 0x43, 0xa6,
-	0x9c, 0x79, 0x51, 0x1a, 0xc0, 0xcc, 0xae, 0xeb, 0xee, 0xdb, 0x8b, 0xdc, 0xb1, 0xd6, 0x8e, 0x9f,
-	0xa8, 0x1c, 0x69, 0xc8, 0x99, 0x0e, 0xf0, 0x63, 0x84, 0x8a, 0x2b, 0xe9, 0x09, 0x0d, 0x3d, 0x61,
-	0x6b, 0x91, 0x3b, 0x4d, 0xcd, 0xea, 0xde, 0xab, 0x10, 0xbb, 0xa8, 0x56, 0xf4, 0x36, 0x75, 0xef,
-	0xf6, 0x22, 0x77, 0xcc, 0x98, 0x85, 0x45, 0xcf, 0x22, 0xa5, 0xa4, 0xe2, 0x90, 0xb0, 0x29, 0x04,
-	0xda, 0x1c, 0x26, 0x59, 0x42, 0xf7, 0xc7, 0x4d, 0x64, 0x9e, 0xcf, 0x08, 0x88, 0x2c, 0x96, 0xf8,
-	0x18, 0x59, 0x3e, 0x4b, 0x25, 0xa7, 0xbe, 0xf4, 0x6e, 0x48, 0xdb, 0x7f, 0x78, 0x95, 0x3b, 0x1f,
-	0x17, 0xfe, 0xbd, 0x7d, 0xc2, 0x25, 0x9d, 0x25, 0x75, 0x50, 0xea, 0xbf, 0x8d, 0x6a, 0xc3, 0x98,
-	0x95, 0xaf, 0x57, 0x9b, 0x14, 0x00, 0x9f, 0x6a, 0xd5, 0xf4, 0x7e, 0xd5, 0x02, 0x5a, 0xfb, 0x0f,
-	0xd7, 0xf7, 0x7b, 0xcb, 0x1e, 0xfd, 0xfb, 0xca, 0xbe, 0x57, 0xb9, 0x73, 0xa7, 0x98, 0x5a, 0x56,
-	0xba, 0x4a, 0x55, 0x6d, 0x1f, 0x0b, 0x55, 0x39, 0x48, 0xbd, 0xae, 0x36, 0x51, 0x21, 0x7e, 0x80,
-	0x4c, 0x0e, 0x53, 0xe0, 0x12, 0x02, 0xbd, 0x16, 0x93, 0x5c, 0x63, 0xfc, 0x09, 0x32, 0x43, 0x2a,
-	0xbc, 0x4c, 0x40, 0x50, 0xec, 0x80, 0x34, 0x42, 0x2a, 0xde, 0x08, 0x08, 0x9e, 0x1b, 0x3f, 0xbd,
-	0x75, 0x36, 0x5c, 0x8a, 0x5a, 0x07, 0xbe, 0x0f, 0x42, 0x9c, 0x67, 0x93, 0x18, 0xfe, 0xc3, 0x5b,
-	0xfb, 0xa8, 0x2d, 0x24, 0xe3, 0x34, 0x04, 0x6f, 0x0c, 0xf3, 0xd2, 0x61, 0x85, 0x5f, 0x4a, 0xfe,
-	0x5b, 0x98, 0x0b, 0xb2, 0x0e, 0xca, 0x11, 0x87, 0xa8, 0x7d, 0xce, 0xa9, 0x0f, 0xfc, 0x90, 0xa5,
-	0x17, 0x51, 0x88, 0x9f, 0xa2, 0x2d, 0x96, 0xc6, 0x73, 0x4f, 0xb2, 0x89, 0xe7, 0xd3, 0x38, 0xd6,
-	0x93, 0xcc, 0xa2, 0x95, 0x4a, 0x9c, 0xb3, 0xc9, 0x21, 0x8d, 0x63, 0xb2, 0x0e, 0xdc, 0xbf, 0xab,
-	0xa8, 0xa5, 0xbb, 0x94, 0x4d, 0x94, 0xd5, 0x75, 0xd3, 0xf2, 0x9e, 0x25, 0x52, 0x0f, 0x20, 0xa3,
-	0x04, 0x58, 0x26, 0xcb, 0x17, 0x71, 0x09, 0x55, 0x05, 0x07, 0x98, 0x81, 0xaf, 0xb7, 0x60, 0x90,
-	0x12, 0xe1, 0x67, 0x68, 0x2b, 0x88, 0x04, 0x1d, 0xc6, 0xe0, 0x09, 0x49, 0xfd, 0x71, 0xa1, 0x61,
-	0xdf, 0x5a, 0xe4, 0x4e, 0xbb, 0x4c, 0xbc, 0x56, 0x3c, 0xb9, 0x81, 0xf0, 0x57, 0xa8, 0xb3, 0x2a,
-	0xd3, 0x8f, 0xac, 0x05, 0x36, 0xfb, 0x78, 0x91, 0x3b, 0x77, 0xae, 0x8f, 0xea, 0x0c, 0xb9, 0x85,
-	0x95, 0x51, 0x02, 0x18, 0x66, 0xa1, 0xf6, 0xae, 0x49, 0x0a, 0xa0, 0xd8, 0x38, 0x4a, 0x22, 0xa9,
-	0xbd, 0x5a, 0x23, 0x05, 0x50, 0xf7, 0x83, 0x54, 0xcf, 0x49, 0x20, 0x61, 0x7c, 0x6e, 0xb7, 0x56,
-	0xf7, 0x2b, 0x12, 0x67, 0x9a, 0x27, 0x37, 0x10, 0xee, 0x23, 0x5c, 0x96, 0x71, 0x90, 0x19, 0x4f,
-	0x3d, 0xfd, 0x0f, 0xd0, 0xd6, 0xb5, 0xfa, 0x3d, 0x2c, 0xb2, 0x44, 0x27, 0x5f, 0x50, 0x49, 0xc9,
-	0xbf, 0x18, 0xfc, 0x1d, 0xda, 0x2a, 0x64, 0xf5, 0x7c, 0xad, 0xba, 0xbd, 0xa5, 0xfd, 0x6b, 0xdf,
-	0xf2, 0xef, 0xf5, 0x6a, 0x8b, 0x4b, 0xc9, 0x35, 0x86, 0xdc, 0x40, 0x03, 0xc3, 0x34, 0xac, 0x5a,
-	0xf1, 0x5f, 0x3a, 0x30, 0x4c, 0x64, 0xb5, 0xae, 0x95, 0x29, 0x1f, 0x8e, 0xdc, 0x5b, 0xe2, 0xb5,
-	0x5b, 0xf7, 0xbf, 0x7e, 0x77, 0xb9, 0x53, 0x79, 0x7f, 0xb9, 0x53, 0xf9, 0xe3, 0x72, 0xa7, 0xf2,
-	0xf3, 0x87, 0x9d, 0x8d, 0xf7, 0x1f, 0x76, 0x36, 0x7e, 0xfb, 0xb0, 0xb3, 0xf1, 0xc3, 0x67, 0xff,
-	0xfb, 0x41, 0x9c, 0xa9, 0x2f, 0xf1, 0xb0, 0xae, 0x3f, 0xb4, 0x4f, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0xab, 0xac, 0xcf, 0xa2, 0xa2, 0x07, 0x00, 0x00,
+	// 1198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0xb6, 0x2c, 0x4a, 0xa2, 0x56, 0x72, 0xa4, 0x6c, 0xfc, 0xe6, 0x65, 0x63, 0x44, 0x14, 0x58,
+	0xa0, 0x50, 0x81, 0x40, 0x6a, 0x1c, 0xa4, 0x87, 0x14, 0x45, 0x6b, 0x29, 0x36, 0x6a, 0xd5, 0x4e,
+	0x83, 0x8d, 0xd3, 0x43, 0x2f, 0xc4, 0x8a, 0x1c, 0x53, 0x84, 0x48, 0xae, 0xc0, 0x5d, 0x0a, 0xd2,
+	0x0f, 0x28, 0xd0, 0x63, 0x7f, 0x42, 0xee, 0xfd, 0x0d, 0xbd, 0x07, 0x3d, 0xe5, 0x58, 0xf4, 0x40,
+	0x14, 0xce, 0xa5, 0xd0, 0xd1, 0xc7, 0x9e, 0x8a, 0x5d, 0x52, 0x1f, 0x76, 0x81, 0xb6, 0x27, 0xcd,
+	0x33, 0x1f, 0xcf, 0xce, 0xce, 0x3c, 0xa4, 0x88, 0xf6, 0x41, 0x8c, 0x7b, 0x30, 0x0b, 0x7b, 0xb3,
+	0xc7, 0xf2, 0xa7, 0x3b, 0x8d, 0x99, 0x60, 0x18, 0x81, 0x18, 0x77, 0x25, 0x9c, 0x3d, 0x7e, 0xb0,
+	0xef, 0x31, 0x8f, 0x29, 0x77, 0x4f, 0x5a, 0x59, 0x86, 0xf5, 0x53, 0x01, 0xe9, 0x27, 0x49, 0x74,
+	0xc1, 0x26, 0x10, 0xe1, 0xd7, 0x08, 0x41, 0xec, 0x1c, 0x7e, 0x62, 0x53, 0xd7, 0x8d, 0x8d, 0x42,
+	0xbb, 0xd0, 0xa9, 0xf6, 0x3f, 0x7d, 0x9b, 0x9a, 0x3b, 0xbf, 0xa5, 0x66, 0xd7, 0xf3, 0xc5, 0x38,
+	0x19, 0x75, 0x1d, 0x16, 0xf6, 0x5e, 0xf8, 0x23, 0x3f, 0x4e, 0x06, 0x63, 0xea, 0x47, 0xbd, 0x48,
+	0xd9, 0xbd, 0xd9, 0x61, 0x4f, 0x9e, 0x75, 0x7c, 0xfa, 0xf2, 0xe9, 0xd3, 0x23, 0xd7, 0x8d, 0x49,
+	0x55, 0x31, 0x49, 0x13, 0x3f, 0x44, 0x68, 0x44, 0xa3, 0x89, 0xed, 0x42, 0xc4, 0x42, 0x63, 0x57,
+	0xd2, 0x92, 0xaa, 0xf4, 0x3c, 0x97, 0x0e, 0xfc, 0x31, 0xba, 0xeb, 0x73, 0x3b, 0xa4, 0x2e, 0xd8,
+	0x97, 0x31, 0x0b, 0x6d, 0x87, 0xf9, 0x91, 0x51, 0x6c, 0x17, 0x3a, 0x3a, 0xb9, 0xe3, 0xf3, 0x73,
+	0xea, 0xc2, 0x49, 0xcc, 0xc2, 0x01, 0xf3, 0x23, 0xeb, 0xe7, 0x22, 0x2a, 0xbf, 0xa4, 0x31, 0x0d,
+	0x39, 0x3e, 0x42, 0x08, 0xe6, 0x22, 0xa6, 0x36, 0xf8, 0x53, 0x6e, 0x68, 0xed, 0x62, 0xa7, 0xd8,
+	0xb7, 0xae, 0x52, 0xb3, 0x7a, 0x2c, 0xbd, 0xc7, 0xa7, 0x2f, 0xf9, 0x75, 0x6a, 0xde, 0x5d, 0xd0,
+	0x30, 0x78, 0x66, 0x6d, 0x12, 0x2d, 0x52, 0x55, 0xe0, 0xd8, 0x9f, 0x72, 0x7c, 0x88, 0xea, 0x30,
+	0x0b, 0x6d, 0x67, 0x4c, 0xa3, 0x08, 0x02, 0x6e, 0xe8, 0xed, 0x62, 0xa7, 0xda, 0x6f, 0x5c, 0xa5,
+	0x66, 0xed, 0xf8, 0xdb, 0xf3, 0x41, 0xee, 0x26, 0x35, 0x98, 0x85, 0x2b, 0x80, 0xcf, 0xd1, 0x3d,
+	0x27, 0x06, 0x2a, 0xc0, 0xbe, 0x4c, 0x22, 0x21, 0xa7, 0x66, 0x5f, 0x02, 0x18, 0x55, 0x35, 0xab,
+	0x87, 0xf9, 0xac, 0xfe, 0xe7, 0x30, 0x1e, 0x32, 0xce, 0xdd, 0x49, 0xd7, 0x67, 0xbd, 0x90, 0x8a,
+	0x71, 0xf7, 0x34, 0x12, 0xe4, 0x6e, 0x56, 0x79, 0x92, 0x17, 0x9e, 0x00, 0xe0, 0xcf, 0xd1, 0xc1,
+	0x88, 0x72, 0x90, 0x1c, 0xaa, 0x0f, 0x0f, 0xb2, 0x29, 0xf9, 0x11, 0x15, 0x2c, 0x36, 0x50, 0xbb,
+	0xd0, 0xd9, 0x23, 0x86, 0x4c, 0x39, 0x01, 0x18, 0xa8, 0x84, 0xe7, 0x9b, 0x38, 0x1e, 0xa0, 0xd6,
+	0xba, 0x1c, 0x02, 0xca, 0x85, 0xef, 0xf8, 0x62, 0x61, 0x87, 0x49, 0x20, 0xfc, 0x69, 0xe0, 0x43,
+	0x6c, 0xd4, 0x14, 0xc3, 0x41, 0xce, 0x70, 0xbc, 0xce, 0x39, 0x5f, 0xa7, 0xe0, 0x2f, 0x50, 0x3d,
+	0xf4, 0x23, 0x7b, 0x45, 0x64, 0xd4, 0xff, 0xcb, 0x5d, 0x50, 0xe8, 0x47, 0xfd, 0x8c, 0xf4, 0x99,
+	0xf6, 0xc7, 0x1b, 0xb3, 0x30, 0xd4, 0xf4, 0x42, 0x73, 0x77, 0xa8, 0xe9, 0xbb, 0xcd, 0xe2, 0x50,
+	0xd3, 0x8b, 0x4d, 0x6d, 0xa8, 0xe9, 0xa5, 0x66, 0x79, 0xa8, 0xe9, 0xe5, 0x66, 0x65, 0xa8, 0xe9,
+	0x95, 0xa6, 0x6e, 0xf5, 0x50, 0xe9, 0x95, 0xa0, 0x02, 0x70, 0x13, 0x15, 0x27, 0xb0, 0xc8, 0x24,
+	0x46, 0xa4, 0x89, 0xf7, 0x51, 0x69, 0x46, 0x83, 0x04, 0x72, 0x7d, 0x64, 0xc0, 0x1a, 0xa2, 0xc6,
+	0x45, 0x4c, 0x23, 0x4e, 0x1d, 0xe1, 0xb3, 0xe8, 0x8c, 0x79, 0x1c, 0x63, 0xa4, 0x8d, 0x29, 0x1f,
+	0xe7, 0xb5, 0xca, 0xc6, 0x1f, 0x22, 0x2d, 0x60, 0x1e, 0x37, 0x76, 0xdb, 0xc5, 0x4e, 0xed, 0xb0,
+	0xd1, 0xdd, 0xc8, 0xbe, 0x7b, 0xc6, 0x3c, 0xa2, 0x82, 0xd6, 0x2f, 0xbb, 0xa8, 0x78, 0xc6, 0x3c,
+	0x6c, 0xa0, 0x8a, 0xd4, 0x37, 0x70, 0x9e, 0x73, 0xac, 0x20, 0xbe, 0x8f, 0xca, 0x82, 0x4d, 0x7d,
+	0x27, 0x23, 0xaa, 0x92, 0x1c, 0xc9, 0x23, 0x5d, 0x2a, 0xa8, 0x12, 0x65, 0x9d, 0x28, 0x5b, 0x8a,
+	0x67, 0x14, 0x30, 0x67, 0x62, 0x47, 0x49, 0x38, 0x82, 0xd8, 0xd0, 0xda, 0x85, 0x8e, 0xd6, 0x6f,
+	0x2c, 0x53, 0xb3, 0xa6, 0xfc, 0x2f, 0x94, 0x9b, 0x6c, 0x03, 0xfc, 0x08, 0x55, 0xc4, 0xdc, 0x56,
+	0xdd, 0x97, 0xd4, 0x90, 0xef, 0x2d, 0x53, 0xb3, 0x21, 0x36, 0x17, 0xfc, 0x8a, 0xf2, 0x31, 0x29,
+	0x8b, 0xb9, 0xfc, 0xc5, 0x3d, 0xa4, 0x8b, 0xb9, 0xed, 0x47, 0x2e, 0xcc, 0x8d, 0xb2, 0x62, 0xdf,
+	0x5f, 0xa6, 0x66, 0x73, 0x2b, 0xfd, 0x54, 0xc6, 0x48, 0x45, 0xcc, 0x95, 0x81, 0x1f, 0x21, 0x94,
+	0xb5, 0xa4, 0x4e, 0xa8, 0xa8, 0x13, 0xf6, 0x96, 0xa9, 0x59, 0x55, 0x5e, 0xc5, 0xbd, 0x31, 0xb1,
+	0x85, 0x4a, 0x19, 0xb7, 0xae, 0xb8, 0xeb, 0xcb, 0xd4, 0xd4, 0x03, 0xe6, 0x65, 0x9c, 0x59, 0x48,
+	0x8e, 0x2a, 0x86, 0x90, 0xcd, 0xc0, 0x55, 0x0a, 0xd7, 0xc9, 0x0a, 0x5a, 0xdf, 0xef, 0x22, 0xfd,
+	0x62, 0x4e, 0x80, 0x27, 0x81, 0xc0, 0x27, 0xa8, 0xe9, 0xb0, 0x48, 0xc4, 0xd4, 0x11, 0xf6, 0x8d,
+	0xd1, 0xf6, 0x0f, 0xae, 0x53, 0xf3, 0xff, 0xd9, 0x43, 0x78, 0x3b, 0xc3, 0x22, 0x8d, 0x95, 0xeb,
+	0x28, 0x9f, 0xff, 0x3e, 0x2a, 0x8d, 0x02, 0x96, 0xbf, 0x23, 0xea, 0x24, 0x03, 0xf8, 0x4c, 0x4d,
+	0x4d, 0xed, 0x57, 0x2e, 0xa0, 0x76, 0x78, 0xb0, 0xbd, 0xdf, 0x5b, 0xf2, 0xe8, 0xdf, 0x97, 0xba,
+	0xbd, 0x4e, 0xcd, 0x3b, 0xd9, 0xa9, 0x79, 0xa5, 0x25, 0xa7, 0xaa, 0xe4, 0xd3, 0x44, 0xc5, 0x18,
+	0x84, 0x5a, 0x57, 0x9d, 0x48, 0x13, 0x3f, 0x40, 0x7a, 0x0c, 0x33, 0x88, 0x05, 0xb8, 0x6a, 0x2d,
+	0x3a, 0x59, 0x63, 0xfc, 0x01, 0xd2, 0x3d, 0xca, 0xed, 0x84, 0x83, 0x9b, 0xed, 0x80, 0x54, 0x3c,
+	0xca, 0x5f, 0x73, 0x70, 0x9f, 0x69, 0x3f, 0xbc, 0x31, 0x77, 0x2c, 0x8a, 0x6a, 0x47, 0x8e, 0x03,
+	0x9c, 0x5f, 0x24, 0xd3, 0x00, 0xfe, 0x41, 0x5b, 0x87, 0xa8, 0xce, 0x05, 0x8b, 0xa9, 0x07, 0xf6,
+	0x04, 0x16, 0xb9, 0xc2, 0x32, 0xbd, 0xe4, 0xfe, 0xaf, 0x61, 0xc1, 0xc9, 0x36, 0xc8, 0x8f, 0x18,
+	0xa0, 0xfa, 0x45, 0x4c, 0x1d, 0x88, 0x07, 0x2c, 0xba, 0xf4, 0x3d, 0xfc, 0x04, 0xed, 0xb1, 0x28,
+	0x58, 0xd8, 0x82, 0x4d, 0x6d, 0x87, 0x06, 0x81, 0x3a, 0x49, 0xcf, 0xa8, 0x64, 0xe0, 0x82, 0x4d,
+	0x07, 0x34, 0x08, 0xc8, 0x36, 0xb0, 0xfe, 0x2c, 0xa2, 0x9a, 0x62, 0xc9, 0x49, 0xa4, 0xd4, 0x15,
+	0x69, 0xde, 0x67, 0x8e, 0xe4, 0x05, 0x84, 0x1f, 0x02, 0x4b, 0x44, 0xfe, 0x20, 0xae, 0xa0, 0xac,
+	0x88, 0x01, 0xe6, 0xe0, 0xa8, 0x2d, 0x68, 0x24, 0x47, 0xf8, 0x29, 0xda, 0x73, 0x7d, 0x4e, 0x47,
+	0x01, 0xd8, 0x5c, 0x50, 0x67, 0x92, 0xcd, 0xb0, 0xdf, 0x5c, 0xa6, 0x66, 0x3d, 0x0f, 0xbc, 0x92,
+	0x7e, 0x72, 0x03, 0xe1, 0xcf, 0x50, 0x63, 0x53, 0xa6, 0xae, 0xac, 0x06, 0xac, 0xf7, 0xf1, 0x32,
+	0x35, 0xef, 0xac, 0x53, 0x55, 0x84, 0xdc, 0xc2, 0x52, 0x28, 0x2e, 0x8c, 0x12, 0x4f, 0x69, 0x57,
+	0x27, 0x19, 0x90, 0xde, 0xc0, 0x0f, 0x7d, 0xa1, 0xb4, 0x5a, 0x22, 0x19, 0x90, 0xfd, 0x41, 0xa4,
+	0xce, 0x09, 0x21, 0x64, 0xf1, 0xc2, 0xa8, 0x6d, 0xfa, 0xcb, 0x02, 0xe7, 0xca, 0x4f, 0x6e, 0x20,
+	0xdc, 0x47, 0x38, 0x2f, 0x8b, 0x41, 0x24, 0x71, 0x64, 0xab, 0x37, 0x40, 0x5d, 0xd5, 0xaa, 0xe7,
+	0x30, 0x8b, 0x12, 0x15, 0x7c, 0x4e, 0x05, 0x25, 0x7f, 0xf3, 0xe0, 0x6f, 0xd0, 0x5e, 0x36, 0x56,
+	0xdb, 0x51, 0x53, 0x37, 0xf6, 0x94, 0x7e, 0x8d, 0x5b, 0xfa, 0x5d, 0xaf, 0x36, 0x6b, 0x4a, 0x6c,
+	0x79, 0xc8, 0x0d, 0x34, 0xd4, 0x74, 0xad, 0x59, 0xca, 0xde, 0xa5, 0x43, 0x4d, 0x47, 0xcd, 0xda,
+	0x7a, 0x32, 0xf9, 0xe5, 0xc8, 0xbd, 0x15, 0xde, 0xea, 0xba, 0xff, 0xe5, 0xdb, 0xab, 0x56, 0xe1,
+	0xdd, 0x55, 0xab, 0xf0, 0xfb, 0x55, 0xab, 0xf0, 0xe3, 0xfb, 0xd6, 0xce, 0xbb, 0xf7, 0xad, 0x9d,
+	0x5f, 0xdf, 0xb7, 0x76, 0xbe, 0xfb, 0xe8, 0x5f, 0xff, 0xd5, 0xe7, 0xf2, 0x73, 0x62, 0x54, 0x56,
+	0x5f, 0x0b, 0x4f, 0xfe, 0x1a, 0x00, 0x00, 0x2d, 0x34, 0xda, 0x67, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -774,6 +804,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.CreateFuntokenFee.Equal(that1.CreateFuntokenFee) {
 		return false
 	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.BaseFeeElasticityMultiplier != that1.BaseFeeElasticityMultiplier {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
 	return true
 }
 func (m *FunToken) Marshal() (dAtA []byte, err error) {
@@ -846,6 +885,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.BaseFeeElasticityMultiplier != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseFeeElasticityMultiplier))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.CreateFuntokenFee.Size()
 		i -= size
@@ -1353,6 +1412,14 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.CreateFuntokenFee.Size()
 	n += 1 + l + sovEvm(uint64(l))
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovEvm(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.BaseFeeElasticityMultiplier != 0 {
+		n += 1 + sovEvm(uint64(m.BaseFeeElasticityMultiplier))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovEvm(uint64(l))
 	return n
 }
 
@@ -1850,6 +1917,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeElasticityMultiplier", wireType)
+			}
+			m.BaseFeeElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
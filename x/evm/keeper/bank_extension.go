@@ -153,3 +153,39 @@ func (bk NibiruBankKeeper) SendCoinsFromModuleToModule(
 	}
 	return nil
 }
+
+func (bk NibiruBankKeeper) DelegateCoinsFromAccountToModule(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	coins sdk.Coins,
+) error {
+	// Use the embedded function from [bankkeeper.Keeper]
+	if err := bk.BaseKeeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, coins); err != nil {
+		return err
+	}
+	if findEtherBalanceChangeFromCoins(coins) {
+		bk.SyncStateDBWithAccount(ctx, senderAddr)
+		moduleBech32Addr := auth.NewModuleAddress(recipientModule)
+		bk.SyncStateDBWithAccount(ctx, moduleBech32Addr)
+	}
+	return nil
+}
+
+func (bk NibiruBankKeeper) UndelegateCoinsFromModuleToAccount(
+	ctx sdk.Context,
+	senderModule string,
+	recipientAddr sdk.AccAddress,
+	coins sdk.Coins,
+) error {
+	// Use the embedded function from [bankkeeper.Keeper]
+	if err := bk.BaseKeeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, coins); err != nil {
+		return err
+	}
+	if findEtherBalanceChangeFromCoins(coins) {
+		moduleBech32Addr := auth.NewModuleAddress(senderModule)
+		bk.SyncStateDBWithAccount(ctx, moduleBech32Addr)
+		bk.SyncStateDBWithAccount(ctx, recipientAddr)
+	}
+	return nil
+}
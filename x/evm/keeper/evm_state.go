@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkstore "github.com/cosmos/cosmos-sdk/store/types"
@@ -39,8 +40,17 @@ type EvmState struct {
 		[]byte,
 	]
 
+	// BaseFee: EIP-1559 base fee of the next block in units of wei per gas,
+	// updated at the end of each block. Wei gives the adjustment more precision
+	// than the base fee in effect, which is a whole number of micronibi.
+	BaseFee collections.Item[sdkmath.Int]
+
 	// BlockGasUsed: Gas used by Ethereum txs in the block (transient).
 	BlockGasUsed collections.ItemTransient[uint64]
+	// BlockEthGasUsed: Gas used by all Ethereum txs in the block (transient).
+	// Unlike "BlockGasUsed", it is not reset between txs, so at the end of the
+	// block it is the total that drives the base fee adjustment.
+	BlockEthGasUsed collections.ItemTransient[uint64]
 	// BlockLogSize: EVM tx log size for the block (transient).
 	BlockLogSize collections.ItemTransient[uint64]
 	// BlockTxIndex: EVM tx index for the block (transient).
@@ -71,11 +81,20 @@ func NewEvmState(
 			collections.PairKeyEncoder(eth.KeyEncoderEthAddr, eth.KeyEncoderEthHash),
 			eth.ValueEncoderBytes,
 		),
+		BaseFee: collections.NewItem(
+			storeKey, evm.KeyPrefixBaseFee,
+			collections.IntValueEncoder,
+		),
 		BlockGasUsed: collections.NewItemTransient(
 			storeKeyTransient,
 			evm.NamespaceBlockGasUsed,
 			collections.Uint64ValueEncoder,
 		),
+		BlockEthGasUsed: collections.NewItemTransient(
+			storeKeyTransient,
+			evm.NamespaceBlockEthGasUsed,
+			collections.Uint64ValueEncoder,
+		),
 		BlockLogSize: collections.NewItemTransient(
 			storeKeyTransient,
 			evm.NamespaceBlockLogSize,
@@ -271,6 +271,92 @@ func (s *FunTokenFromCoinSuite) TestConvertCoinToEvmAndBack() {
 	s.Require().ErrorContains(err, "transfer amount exceeds balance")
 }
 
+func (s *FunTokenFromCoinSuite) TestConvertEvmToCoin() {
+	deps := evmtest.NewTestDeps()
+	alice := evmtest.NewEthPrivAcc()
+	bankDenom := evm.EVMBankDenom
+
+	// Initial setup
+	funToken := s.fundAndCreateFunToken(deps, 100)
+
+	s.T().Log("Convert bank coin to erc-20")
+	_, err := deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:   deps.Sender.NibiruAddr.String(),
+			BankCoin: sdk.NewCoin(bankDenom, sdk.NewInt(10)),
+			ToEthAddr: eth.EIP55Addr{
+				Address: alice.EthAddr,
+			},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.T().Log("Convert erc-20 back to bank coin")
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:     alice.NibiruAddr.String(),
+			Erc20Addr:  funToken.Erc20Addr,
+			Amount:     sdk.NewInt(4),
+			ToBankAddr: deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+
+	s.T().Log("Check typed event")
+	testutil.RequireContainsTypedEvent(
+		s.T(),
+		deps.Ctx,
+		&evm.EventConvertEvmToCoin{
+			Sender:               alice.NibiruAddr.String(),
+			Erc20ContractAddress: funToken.Erc20Addr.String(),
+			ToBankAddr:           deps.Sender.NibiruAddr.String(),
+			BankCoin:             sdk.NewCoin(bankDenom, sdk.NewInt(4)),
+		},
+	)
+
+	// Check 1: module balance
+	moduleBalance := deps.App.BankKeeper.GetBalance(deps.Ctx, authtypes.NewModuleAddress(evm.ModuleName), bankDenom)
+	s.Require().Equal(sdk.NewInt(6), moduleBalance.Amount)
+
+	// Check 2: Sender balance
+	senderBalance := deps.App.BankKeeper.GetBalance(deps.Ctx, deps.Sender.NibiruAddr, bankDenom)
+	s.Require().Equal(sdk.NewInt(94), senderBalance.Amount)
+
+	// Check 3: erc-20 balance and supply
+	balance, err := deps.EvmKeeper.ERC20().BalanceOf(funToken.Erc20Addr.Address, alice.EthAddr, deps.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal("6", balance.String())
+	evmtest.AssertERC20BalanceEqual(
+		s.T(), deps, funToken.Erc20Addr.Address, evm.EVM_MODULE_ADDRESS, big.NewInt(0),
+	)
+
+	s.T().Log("sad: Convert more erc-20 to bank coin, insufficient funds")
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:     alice.NibiruAddr.String(),
+			Erc20Addr:  funToken.Erc20Addr,
+			Amount:     sdk.NewInt(7),
+			ToBankAddr: deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().ErrorContains(err, "transfer amount exceeds balance")
+
+	s.T().Log("sad: ERC20 without a FunToken mapping")
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:     alice.NibiruAddr.String(),
+			Erc20Addr:  eth.EIP55Addr{Address: alice.EthAddr},
+			Amount:     sdk.NewInt(1),
+			ToBankAddr: deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().ErrorContains(err, "does not exist")
+}
+
 // TestNativeSendThenPrecompileSend tests a race condition where the state DB
 // commit may overwrite the state after the precompile execution, potentially
 // causing a loss of funds.
@@ -287,6 +287,98 @@ func (s *FunTokenFromErc20Suite) TestSendFromEvmToBank() {
 	s.Require().Error(err)
 }
 
+func (s *FunTokenFromErc20Suite) TestConvertEvmToCoin() {
+	deps := evmtest.NewTestDeps()
+
+	s.T().Log("Deploy ERC20")
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_ERC20Minter,
+		"erc20name", "TOKEN", uint8(18),
+	)
+	s.Require().NoError(err)
+
+	s.T().Log("CreateFunToken for the ERC20")
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+	resp, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromErc20: &eth.EIP55Addr{
+				Address: deployResp.ContractAddr,
+			},
+			Sender: deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	bankDenom := resp.FuntokenMapping.BankDenom
+
+	s.T().Logf("mint erc20 tokens to %s", deps.Sender.EthAddr.String())
+	_, err = deps.EvmKeeper.CallContract(
+		deps.Ctx,
+		embeds.SmartContract_ERC20Minter.ABI,
+		deps.Sender.EthAddr,
+		&deployResp.ContractAddr,
+		true,
+		keeper.Erc20GasLimitExecute,
+		"mint",
+		deps.Sender.EthAddr,
+		big.NewInt(69_420),
+	)
+	s.Require().NoError(err)
+
+	randomAcc := testutil.AccAddress()
+
+	s.T().Log("convert erc20 tokens to bank coins")
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:     deps.Sender.NibiruAddr.String(),
+			Erc20Addr:  eth.EIP55Addr{Address: deployResp.ContractAddr},
+			Amount:     sdk.NewInt(420),
+			ToBankAddr: randomAcc.String(),
+		},
+	)
+	s.Require().NoError(err)
+
+	s.T().Log("check typed event")
+	testutil.RequireContainsTypedEvent(
+		s.T(),
+		deps.Ctx,
+		&evm.EventConvertEvmToCoin{
+			Sender:               deps.Sender.NibiruAddr.String(),
+			Erc20ContractAddress: deployResp.ContractAddr.String(),
+			ToBankAddr:           randomAcc.String(),
+			BankCoin:             sdk.NewCoin(bankDenom, sdk.NewInt(420)),
+		},
+	)
+
+	s.T().Log("check balances")
+	evmtest.AssertERC20BalanceEqual(s.T(), deps, deployResp.ContractAddr, deps.Sender.EthAddr, big.NewInt(69_000))
+	evmtest.AssertERC20BalanceEqual(s.T(), deps, deployResp.ContractAddr, evm.EVM_MODULE_ADDRESS, big.NewInt(420))
+	s.Require().Equal(sdk.NewInt(420),
+		deps.App.BankKeeper.GetBalance(deps.Ctx, randomAcc, bankDenom).Amount,
+	)
+	s.Require().Equal(sdk.NewInt(420),
+		deps.App.BankKeeper.GetSupply(deps.Ctx, bankDenom).Amount,
+	)
+
+	s.T().Log("sad: convert too many erc20 tokens to bank coins")
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:     deps.Sender.NibiruAddr.String(),
+			Erc20Addr:  eth.EIP55Addr{Address: deployResp.ContractAddr},
+			Amount:     sdk.NewInt(70_000),
+			ToBankAddr: randomAcc.String(),
+		},
+	)
+	s.Require().ErrorContains(err, "transfer amount exceeds balance")
+}
+
 // TestCreateFunTokenFromERC20MaliciousName tries to create funtoken from a contract
 // with a malicious (gas intensive) name() function.
 // Fun token should fail creation with "out of gas"
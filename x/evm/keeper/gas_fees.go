@@ -147,7 +147,7 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 func VerifyFee(
 	txData evm.TxData,
 	denom string,
-	baseFeeMicronibi *big.Int,
+	baseFeeWei *big.Int,
 	isCheckTx bool,
 ) (sdk.Coins, error) {
 	isContractCreation := txData.GetTo() == nil
@@ -176,8 +176,8 @@ func VerifyFee(
 		)
 	}
 
-	if baseFeeMicronibi == nil {
-		baseFeeMicronibi = evm.BASE_FEE_MICRONIBI
+	if baseFeeWei == nil {
+		baseFeeWei = evm.BASE_FEE_WEI
 	}
 
 	// gasFeeCapMicronibi := evm.WeiToNative(txData.GetGasFeeCapWei())
@@ -190,7 +190,6 @@ func VerifyFee(
 	// 	)
 	// }
 
-	baseFeeWei := evm.NativeToWei(baseFeeMicronibi)
 	feeAmtMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(baseFeeWei))
 	if feeAmtMicronibi.Sign() == 0 {
 		// zero fee, no need to deduct
@@ -199,3 +198,74 @@ func VerifyFee(
 
 	return sdk.Coins{{Denom: denom, Amount: sdkmath.NewIntFromBigInt(feeAmtMicronibi)}}, nil
 }
+
+// UpdateBaseFee sets the base fee of the next block from the gas used by the
+// Ethereum txs of the current block, following EIP-1559. The gas target of a
+// block is the block gas limit divided by the "base_fee_elasticity_multiplier"
+// param. The base fee is left unchanged if the dynamic base fee is disabled or
+// if the block gas limit is unbounded. Called in EndBlock.
+func (k *Keeper) UpdateBaseFee(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.IsDynamicBaseFee() {
+		return
+	}
+	consensusParams := ctx.ConsensusParams()
+	if consensusParams == nil || consensusParams.Block == nil || consensusParams.Block.MaxGas <= 0 {
+		return
+	}
+	gasTarget := uint64(consensusParams.Block.MaxGas) / uint64(params.BaseFeeElasticityMultiplier)
+	if gasTarget == 0 {
+		return
+	}
+
+	nextBaseFeeWei := CalcBaseFeeWei(
+		k.baseFeeWei(ctx),
+		k.EvmState.BlockEthGasUsed.GetOr(ctx, 0),
+		gasTarget,
+		params.BaseFeeChangeDenominator,
+		evm.NativeToWei(params.MinBaseFeeMicronibi()),
+	)
+	k.EvmState.BaseFee.Set(ctx, sdkmath.NewIntFromBigInt(nextBaseFeeWei))
+}
+
+// CalcBaseFeeWei computes the base fee of the next block from the base fee and
+// gas used of the current block with the EIP-1559 update rule:
+//
+//	next = parent + parent * (gasUsed - gasTarget) / gasTarget / changeDenominator
+//
+// A block above its gas target raises the base fee by at least 1 wei, and the
+// result is never below "minBaseFeeWei". Both "gasTarget" and
+// "changeDenominator" must be positive.
+func CalcBaseFeeWei(
+	parentBaseFeeWei *big.Int,
+	gasUsed uint64,
+	gasTarget uint64,
+	changeDenominator uint32,
+	minBaseFeeWei *big.Int,
+) *big.Int {
+	nextBaseFeeWei := new(big.Int).Set(parentBaseFeeWei)
+	if gasUsed != gasTarget {
+		var gasDelta uint64
+		if gasUsed > gasTarget {
+			gasDelta = gasUsed - gasTarget
+		} else {
+			gasDelta = gasTarget - gasUsed
+		}
+		feeDelta := new(big.Int).Mul(parentBaseFeeWei, new(big.Int).SetUint64(gasDelta))
+		feeDelta.Quo(feeDelta, new(big.Int).SetUint64(gasTarget))
+		feeDelta.Quo(feeDelta, big.NewInt(int64(changeDenominator)))
+		if gasUsed > gasTarget {
+			if feeDelta.Sign() == 0 {
+				feeDelta.SetInt64(1)
+			}
+			nextBaseFeeWei.Add(nextBaseFeeWei, feeDelta)
+		} else {
+			nextBaseFeeWei.Sub(nextBaseFeeWei, feeDelta)
+		}
+	}
+
+	if nextBaseFeeWei.Cmp(minBaseFeeWei) < 0 {
+		return new(big.Int).Set(minBaseFeeWei)
+	}
+	return nextBaseFeeWei
+}
@@ -4,6 +4,7 @@ package keeper_test
 import (
 	"math/big"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -19,16 +20,16 @@ import (
 )
 
 // TestVerifyFee asserts that the result of VerifyFee is the effective fee
-// in units of micronibi per gas.
+// in units of micronibi.
 func (s *Suite) TestVerifyFee() {
-	baseFeeMicronibi := evm.BASE_FEE_MICRONIBI
+	baseFeeWei := evm.BASE_FEE_WEI
 
 	type testCase struct {
-		name             string
-		txData           evm.TxData
-		baseFeeMicronibi *big.Int
-		wantCoinAmt      string
-		wantErr          string
+		name        string
+		txData      evm.TxData
+		baseFeeWei  *big.Int
+		wantCoinAmt string
+		wantErr     string
 	}
 
 	for _, getTestCase := range []func() testCase{
@@ -36,11 +37,11 @@ func (s *Suite) TestVerifyFee() {
 			txData := evmtest.ValidLegacyTx()
 			effectiveFeeMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(nil))
 			return testCase{
-				name:             "happy: legacy tx",
-				txData:           txData,
-				baseFeeMicronibi: baseFeeMicronibi,
-				wantCoinAmt:      effectiveFeeMicronibi.String(),
-				wantErr:          "",
+				name:        "happy: legacy tx",
+				txData:      txData,
+				baseFeeWei:  baseFeeWei,
+				wantCoinAmt: effectiveFeeMicronibi.String(),
+				wantErr:     "",
 			}
 		},
 		func() testCase {
@@ -48,11 +49,11 @@ func (s *Suite) TestVerifyFee() {
 			txData.GasLimit = gethparams.TxGas - 1
 			effectiveFeeMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(nil))
 			return testCase{
-				name:             "sad: gas limit lower than global tx gas cost",
-				txData:           txData,
-				baseFeeMicronibi: baseFeeMicronibi,
-				wantCoinAmt:      effectiveFeeMicronibi.String(),
-				wantErr:          "gas limit too low",
+				name:        "sad: gas limit lower than global tx gas cost",
+				txData:      txData,
+				baseFeeWei:  baseFeeWei,
+				wantCoinAmt: effectiveFeeMicronibi.String(),
+				wantErr:     "gas limit too low",
 			}
 		},
 		func() testCase {
@@ -60,7 +61,6 @@ func (s *Suite) TestVerifyFee() {
 
 			// Set a gas price that would make the gas fee cap "too low", i.e.
 			// lower than the base fee
-			baseFeeWei := evm.NativeToWei(baseFeeMicronibi)
 			lowGasPrice := sdkmath.NewIntFromBigInt(
 				new(big.Int).Sub(baseFeeWei, big.NewInt(1)),
 			)
@@ -69,11 +69,32 @@ func (s *Suite) TestVerifyFee() {
 			effectiveFeeMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(baseFeeWei))
 
 			return testCase{
-				name:             "happy: gas fee cap lower than base fee",
-				txData:           txData,
-				baseFeeMicronibi: baseFeeMicronibi,
-				wantCoinAmt:      effectiveFeeMicronibi.String(),
-				wantErr:          "",
+				name:        "happy: gas fee cap lower than base fee",
+				txData:      txData,
+				baseFeeWei:  baseFeeWei,
+				wantCoinAmt: effectiveFeeMicronibi.String(),
+				wantErr:     "",
+			}
+		},
+		func() testCase {
+			// A base fee that is not a whole number of micronibi is charged as
+			// is: 21000 gas at 1.125 micronibi per gas.
+			baseFeeWei := big.NewInt(1_125_000_000_000)
+			zeroTip := sdkmath.ZeroInt()
+			gasFeeCap := sdkmath.NewIntFromBigInt(evm.NativeToWei(big.NewInt(2)))
+			txData := &evm.DynamicFeeTx{
+				GasTipCap: &zeroTip,
+				GasFeeCap: &gasFeeCap,
+				GasLimit:  gethparams.TxGas,
+				To:        evmtest.NewEthPrivAcc().EthAddr.Hex(),
+			}
+
+			return testCase{
+				name:        "happy: dynamic fee tx pays a base fee between whole micronibi",
+				txData:      txData,
+				baseFeeWei:  baseFeeWei,
+				wantCoinAmt: "23625",
+				wantErr:     "",
 			}
 		},
 		func() testCase {
@@ -83,7 +104,7 @@ func (s *Suite) TestVerifyFee() {
 			gasPrice := sdkmath.ZeroInt()
 			txData.GasLimit = gethparams.TxGas // needed for intrinsic gas
 			txData.GasPrice = &gasPrice
-			baseFeeMicronibi := big.NewInt(0)
+			baseFeeWei := big.NewInt(0)
 
 			// Expect a cost to be 0
 			wantCoinAmt := "0"
@@ -93,11 +114,11 @@ func (s *Suite) TestVerifyFee() {
 			return testCase{
 				// This is impossible because base fee is 1 unibi, however this
 				// case is technically valid.
-				name:             "happy: the impossible zero case",
-				txData:           txData,
-				baseFeeMicronibi: baseFeeMicronibi,
-				wantCoinAmt:      "0",
-				wantErr:          "",
+				name:        "happy: the impossible zero case",
+				txData:      txData,
+				baseFeeWei:  baseFeeWei,
+				wantCoinAmt: "0",
+				wantErr:     "",
 			}
 		},
 	} {
@@ -106,7 +127,7 @@ func (s *Suite) TestVerifyFee() {
 		tc := getTestCase()
 		s.Run(tc.name, func() {
 			gotCoins, err := evmkeeper.VerifyFee(
-				tc.txData, feeDenom, tc.baseFeeMicronibi, isCheckTx,
+				tc.txData, feeDenom, tc.baseFeeWei, isCheckTx,
 			)
 			if tc.wantErr != "" {
 				s.Require().ErrorContains(err, tc.wantErr)
@@ -255,3 +276,101 @@ func (s *Suite) TestRefundGas() {
 		})
 	}
 }
+
+func (s *Suite) TestCalcBaseFeeWei() {
+	minBaseFeeWei := evm.NativeToWei(big.NewInt(1))
+	parentWei := evm.NativeToWei(big.NewInt(100))
+	for _, tc := range []struct {
+		name      string
+		parentWei *big.Int
+		gasUsed   uint64
+		wantWei   *big.Int
+	}{
+		{
+			name:      "at gas target: unchanged",
+			parentWei: parentWei,
+			gasUsed:   500,
+			wantWei:   parentWei,
+		},
+		{
+			name:      "full block: +1/8",
+			parentWei: parentWei,
+			gasUsed:   1_000,
+			wantWei:   big.NewInt(112_500_000_000_000),
+		},
+		{
+			name:      "empty block: -1/8",
+			parentWei: parentWei,
+			gasUsed:   0,
+			wantWei:   big.NewInt(87_500_000_000_000),
+		},
+		{
+			name:      "slightly above gas target: small increase",
+			parentWei: new(big.Int).Add(minBaseFeeWei, big.NewInt(1)),
+			gasUsed:   500 + 1,
+			wantWei:   new(big.Int).Add(minBaseFeeWei, big.NewInt(250_000_001)),
+		},
+		{
+			name:      "empty block: bounded below by min base fee",
+			parentWei: minBaseFeeWei,
+			gasUsed:   0,
+			wantWei:   minBaseFeeWei,
+		},
+	} {
+		s.Run(tc.name, func() {
+			gotWei := evmkeeper.CalcBaseFeeWei(tc.parentWei, tc.gasUsed, 500, 8, minBaseFeeWei)
+			s.Equal(tc.wantWei.String(), gotWei.String())
+		})
+	}
+}
+
+func (s *Suite) TestUpdateBaseFee() {
+	deps := evmtest.NewTestDeps()
+	params := deps.EvmKeeper.GetParams(deps.Ctx)
+	s.Require().True(params.IsDynamicBaseFee())
+	s.Equal(evm.BASE_FEE_MICRONIBI, deps.EvmKeeper.BaseFeeMicronibiPerGas(deps.Ctx))
+
+	maxGas := int64(1_000_000)
+	deps.Ctx = deps.Ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: maxGas},
+	})
+	fullBlock := func() {
+		deps.EvmKeeper.EvmState.BlockEthGasUsed.Set(deps.Ctx, uint64(maxGas))
+		deps.EvmKeeper.UpdateBaseFee(deps.Ctx)
+	}
+
+	s.T().Log("Full blocks raise the base fee in effect by 1/8 per block, also near the min base fee")
+	wantWei := []string{
+		"1125000000000",
+		"1265625000000",
+		"1423828125000",
+		"1601806640625",
+		"1802032470703",
+		"2027286529540",
+	}
+	for _, want := range wantWei {
+		fullBlock()
+		s.Equal(want, deps.EvmKeeper.BaseFeeWeiPerGas(deps.Ctx).String())
+	}
+	s.Equal("2", deps.EvmKeeper.BaseFeeMicronibiPerGas(deps.Ctx).String(),
+		"the base fee in micronibi is rounded down")
+
+	s.T().Log("Empty blocks lower the base fee down to the min base fee")
+	for i := 0; i < 20; i++ {
+		deps.EvmKeeper.EvmState.BlockEthGasUsed.Set(deps.Ctx, 0)
+		deps.EvmKeeper.UpdateBaseFee(deps.Ctx)
+	}
+	s.Equal(evm.BASE_FEE_MICRONIBI.String(), deps.EvmKeeper.BaseFeeMicronibiPerGas(deps.Ctx).String())
+
+	s.T().Log("Raising the min base fee raises the base fee in effect")
+	params.MinBaseFee = math.NewInt(5)
+	s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx, params))
+	s.Equal("5", deps.EvmKeeper.BaseFeeMicronibiPerGas(deps.Ctx).String())
+
+	s.T().Log("Disabling the dynamic base fee fixes it at the min base fee")
+	fullBlock()
+	params.BaseFeeChangeDenominator = 0
+	s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx, params))
+	fullBlock()
+	s.Equal("5", deps.EvmKeeper.BaseFeeMicronibiPerGas(deps.Ctx).String())
+}
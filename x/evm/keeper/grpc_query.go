@@ -2,11 +2,13 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	grpccodes "google.golang.org/grpc/codes"
@@ -145,9 +147,7 @@ func (k Keeper) BaseFee(
 ) (*evm.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	baseFeeMicronibiPerGas := sdkmath.NewIntFromBigInt(k.BaseFeeMicronibiPerGas(ctx))
-	baseFeeWei := sdkmath.NewIntFromBigInt(
-		evm.NativeToWei(baseFeeMicronibiPerGas.BigInt()),
-	)
+	baseFeeWei := sdkmath.NewIntFromBigInt(k.BaseFeeWeiPerGas(ctx))
 	return &evm.QueryBaseFeeResponse{
 		BaseFee:      &baseFeeWei,
 		BaseFeeUnibi: &baseFeeMicronibiPerGas,
@@ -272,6 +272,11 @@ func (k *Keeper) EthCall(
 		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
 	}
 
+	ctx, err = k.applyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetAccNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -292,6 +297,81 @@ func (k *Keeper) EthCall(
 	return res, nil
 }
 
+// applyStateOverrides branches off a cache of the given context and writes the
+// JSON-encoded [evm.StateOverride] into it. The returned context is what the
+// call should execute against. The original context is returned unchanged if
+// no overrides are given.
+func (k *Keeper) applyStateOverrides(
+	ctx sdk.Context, overridesJSON []byte,
+) (sdk.Context, error) {
+	if len(overridesJSON) == 0 {
+		return ctx, nil
+	}
+	var overrides evm.StateOverride
+	if err := json.Unmarshal(overridesJSON, &overrides); err != nil {
+		return ctx, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid state overrides: %s", err)
+	}
+	return k.applyStateOverride(ctx, overrides)
+}
+
+// applyStateOverride is [Keeper.applyStateOverrides] for decoded overrides.
+func (k *Keeper) applyStateOverride(
+	ctx sdk.Context, overrides evm.StateOverride,
+) (sdk.Context, error) {
+	if err := overrides.Validate(); err != nil {
+		return ctx, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	if len(overrides) == 0 {
+		return ctx, nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	for addr, account := range overrides {
+		if account.State == nil {
+			continue
+		}
+		// A full "state" override replaces the account storage, so every
+		// existing slot is cleared first.
+		var keys []gethcommon.Hash
+		k.ForEachStorage(cacheCtx, addr, func(key, _ gethcommon.Hash) bool {
+			keys = append(keys, key)
+			return true
+		})
+		for _, key := range keys {
+			k.EvmState.SetAccState(cacheCtx, addr, key, nil)
+		}
+	}
+
+	// statedb.New rather than [Keeper.NewStateDB], which would leave the
+	// query StateDB set on the Bank keeper shared with DeliverTx.
+	db := statedb.New(cacheCtx, k, statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash())))
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			db.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			db.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			db.SetBalanceWei(addr, (*big.Int)(*account.Balance))
+		}
+		if account.State != nil {
+			for key, value := range *account.State {
+				db.SetState(addr, key, value)
+			}
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				db.SetState(addr, key, value)
+			}
+		}
+	}
+	if err := db.Commit(); err != nil {
+		return ctx, grpcstatus.Errorf(grpccodes.Internal, "failed to apply state overrides: %s", err)
+	}
+	return cacheCtx, nil
+}
+
 // EstimateGas: Implements the gRPC query for "/eth.evm.v1.Query/EstimateGas".
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(
@@ -338,6 +418,11 @@ func (k Keeper) EstimateGasForEvmCallType(
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
 	}
 
+	ctx, err = k.applyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetAccNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -461,6 +546,95 @@ func (k Keeper) EstimateGasForEvmCallType(
 	return &evm.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList: Implements the gRPC query for
+// "/eth.evm.v1.Query/CreateAccessList". CreateAccessList implements the
+// "eth_createAccessList" JSON-RPC method.
+//
+// The call is executed with the access list tracer, starting from the access
+// list given in the args. Because sending the call with an access list can
+// change its execution path, the call is repeated with the access list of the
+// previous run until the access list is stable. The tracer collects the
+// access list in maps, so the result is sorted to be deterministic.
+func (k *Keeper) CreateAccessList(
+	goCtx context.Context, req *evm.EthCallRequest,
+) (*evm.CreateAccessListResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var args evm.JsonTxArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	chainID := k.EthChainID(ctx)
+	cfg, err := k.GetEVMConfig(ctx, ParseProposerAddr(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	ctx, err = k.applyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetAccNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFeeWei)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash()))
+	precompileAddrs := k.precompiles.Keys()
+
+	prevTracer := evm.NewAccessListTracer(msg, precompileAddrs)
+	for {
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+		msg, err = args.ToMessage(req.GasCap, cfg.BaseFeeWei)
+		if err != nil {
+			return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+		}
+
+		tracer := evm.NewAccessListTracer(msg, precompileAddrs)
+		// Each run starts from the same state. Pass false to not commit StateDB.
+		tmpCtx, _ := ctx.CacheContext()
+		res, _, err := k.ApplyEvmMsg(tmpCtx, msg, tracer, false, cfg, txConfig, false)
+		if err != nil {
+			return nil, grpcstatus.Errorf(
+				grpccodes.Internal, "failed to apply transaction: %s", err.Error(),
+			)
+		}
+		if tracer.Equal(prevTracer) {
+			sortAccessList(accessList)
+			return &evm.CreateAccessListResponse{
+				AccessList: evm.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
+// sortAccessList sorts the tuples of an access list by address and the storage
+// keys of each tuple.
+func sortAccessList(accessList gethcore.AccessList) {
+	slices.SortFunc(accessList, func(a, b gethcore.AccessTuple) int {
+		return bytes.Compare(a.Address.Bytes(), b.Address.Bytes())
+	})
+	for _, tuple := range accessList {
+		slices.SortFunc(tuple.StorageKeys, func(a, b gethcommon.Hash) int {
+			return bytes.Compare(a.Bytes(), b.Bytes())
+		})
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -495,9 +669,8 @@ func (k Keeper) TraceTx(
 	}
 
 	// compute and use base fee of the height that is being traced
-	baseFeeMicronibiPerGas := k.BaseFeeMicronibiPerGas(ctx)
-	if baseFeeMicronibiPerGas != nil {
-		cfg.BaseFeeWei = baseFeeMicronibiPerGas
+	if baseFeeWeiPerGas := k.BaseFeeWeiPerGas(ctx); baseFeeWeiPerGas != nil {
+		cfg.BaseFeeWei = baseFeeWeiPerGas
 	}
 
 	signer := gethcore.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
@@ -594,9 +767,8 @@ func (k Keeper) TraceCall(
 	}
 
 	// compute and use base fee of the height that is being traced
-	baseFeeMicronibi := k.BaseFeeMicronibiPerGas(ctx)
-	if baseFeeMicronibi != nil {
-		cfg.BaseFeeWei = baseFeeMicronibi
+	if baseFeeWeiPerGas := k.BaseFeeWeiPerGas(ctx); baseFeeWeiPerGas != nil {
+		cfg.BaseFeeWei = baseFeeWeiPerGas
 	}
 
 	txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash().Bytes()))
@@ -682,8 +854,7 @@ func (k Keeper) TraceBlock(
 	}
 
 	// compute and use base fee of height that is being traced
-	if baseFeeMicronibiPerGas := k.BaseFeeMicronibiPerGas(ctx); baseFeeMicronibiPerGas != nil {
-		baseFeeWeiPerGas := evm.NativeToWei(baseFeeMicronibiPerGas)
+	if baseFeeWeiPerGas := k.BaseFeeWeiPerGas(ctx); baseFeeWeiPerGas != nil {
 		cfg.BaseFeeWei = baseFeeWeiPerGas
 	}
 	var tracerConfig json.RawMessage
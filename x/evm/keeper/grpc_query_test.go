@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	gethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
@@ -525,6 +526,169 @@ func (s *Suite) TestQueryEthCall() {
 	}
 }
 
+func (s *Suite) TestQueryEthCallStateOverrides() {
+	// Runtime bytecode that returns the value of storage slot 0:
+	// PUSH1 0 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	codeReturnSlot0 := hexutil.MustDecode("0x60005460005260206000f3")
+	// Runtime bytecode that returns the balance of the caller:
+	// CALLER BALANCE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	codeReturnCallerBalance := hexutil.MustDecode("0x333160005260206000f3")
+	// Runtime bytecode that creates an empty contract and returns its address:
+	// PUSH1 0 PUSH1 0 PUSH1 0 CREATE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	codeCreateAndReturnAddr := hexutil.MustDecode("0x600060006000f060005260206000f3")
+
+	slot0 := gethcommon.BigToHash(big.NewInt(0))
+	slot1 := gethcommon.BigToHash(big.NewInt(1))
+	valueInKeeper := gethcommon.BigToHash(big.NewInt(7))
+	valueOverride := gethcommon.BigToHash(big.NewInt(42))
+
+	contractAddr := evmtest.NewEthPrivAcc().EthAddr
+
+	newReq := func(
+		deps *evmtest.TestDeps, overrides evm.StateOverride,
+	) *evm.EthCallRequest {
+		jsonTxArgs, err := json.Marshal(&evm.JsonTxArgs{
+			From: &deps.Sender.EthAddr,
+			To:   &contractAddr,
+		})
+		s.Require().NoError(err)
+		overridesJson, err := json.Marshal(overrides)
+		s.Require().NoError(err)
+		return &evm.EthCallRequest{
+			Args:      jsonTxArgs,
+			GasCap:    100_000,
+			Overrides: overridesJson,
+		}
+	}
+	// setupContract deploys the given runtime code at contractAddr with a
+	// value already in slot 0.
+	setupContract := func(deps *evmtest.TestDeps, code []byte) {
+		db := deps.NewStateDB()
+		db.SetCode(contractAddr, code)
+		db.SetState(contractAddr, slot0, valueInKeeper)
+		s.Require().NoError(db.Commit())
+	}
+
+	type In = *evm.EthCallRequest
+	type Out = []byte
+	testCases := []TestCase[In, Out]{
+		{
+			name: "happy: no overrides reads keeper state",
+			setup: func(deps *evmtest.TestDeps) {
+				setupContract(deps, codeReturnSlot0)
+			},
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				return newReq(deps, nil), valueInKeeper.Bytes()
+			},
+		},
+		{
+			name: "happy: code override",
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				code := hexutil.Bytes(codeReturnSlot0)
+				stateDiff := map[gethcommon.Hash]gethcommon.Hash{slot0: valueOverride}
+				return newReq(deps, evm.StateOverride{
+					contractAddr: {Code: &code, StateDiff: &stateDiff},
+				}), valueOverride.Bytes()
+			},
+		},
+		{
+			name: "happy: stateDiff override keeps other slots",
+			setup: func(deps *evmtest.TestDeps) {
+				setupContract(deps, codeReturnSlot0)
+			},
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				stateDiff := map[gethcommon.Hash]gethcommon.Hash{slot1: valueOverride}
+				return newReq(deps, evm.StateOverride{
+					contractAddr: {StateDiff: &stateDiff},
+				}), valueInKeeper.Bytes()
+			},
+		},
+		{
+			name: "happy: state override clears other slots",
+			setup: func(deps *evmtest.TestDeps) {
+				setupContract(deps, codeReturnSlot0)
+			},
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				state := map[gethcommon.Hash]gethcommon.Hash{slot1: valueOverride}
+				return newReq(deps, evm.StateOverride{
+					contractAddr: {State: &state},
+				}), gethcommon.Hash{}.Bytes()
+			},
+		},
+		{
+			name: "happy: balance override",
+			setup: func(deps *evmtest.TestDeps) {
+				setupContract(deps, codeReturnCallerBalance)
+			},
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				balanceWei := evm.NativeToWei(big.NewInt(420))
+				balance := (*hexutil.Big)(balanceWei)
+				return newReq(deps, evm.StateOverride{
+					deps.Sender.EthAddr: {Balance: &balance},
+				}), gethcommon.BigToHash(balanceWei).Bytes()
+			},
+		},
+		{
+			name: "happy: nonce override",
+			setup: func(deps *evmtest.TestDeps) {
+				setupContract(deps, codeCreateAndReturnAddr)
+			},
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				nonce := hexutil.Uint64(9)
+				wantAddr := crypto.CreateAddress(contractAddr, 9)
+				return newReq(deps, evm.StateOverride{
+					contractAddr: {Nonce: &nonce},
+				}), gethcommon.BytesToHash(wantAddr.Bytes()).Bytes()
+			},
+		},
+		{
+			name: "sad: state and stateDiff on the same account",
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				state := map[gethcommon.Hash]gethcommon.Hash{slot0: valueOverride}
+				return newReq(deps, evm.StateOverride{
+					contractAddr: {State: &state, StateDiff: &state},
+				}), nil
+			},
+			wantErr: "both 'state' and 'stateDiff'",
+		},
+		{
+			name: "sad: invalid overrides",
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				req = newReq(deps, nil)
+				req.Overrides = []byte("invalid")
+				return req, nil
+			},
+			wantErr: "InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			deps := evmtest.NewTestDeps()
+			if tc.setup != nil {
+				tc.setup(&deps)
+			}
+			req, wantResp := tc.scenario(&deps)
+			stateDBBefore := deps.EvmKeeper.Bank.StateDB
+			gotResp, err := deps.App.EvmKeeper.EthCall(sdk.WrapSDKContext(deps.Ctx), req)
+			// The query must not leave its StateDB on the shared Bank keeper.
+			s.Same(stateDBBefore, deps.EvmKeeper.Bank.StateDB)
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Empty(gotResp.VmError)
+			s.Equal(wantResp, gotResp.Ret)
+
+			// Overrides must not leak into the queried state.
+			if tc.setup != nil {
+				s.Equal(valueInKeeper, deps.EvmKeeper.GetState(deps.Ctx, contractAddr, slot0))
+			}
+		})
+	}
+}
+
 func (s *Suite) TestQueryBalance() {
 	type In = *evm.QueryBalanceRequest
 	type Out = *evm.QueryBalanceResponse
@@ -742,6 +906,35 @@ func (s *Suite) TestEstimateGasForEvmCallType() {
 			},
 			wantErr: "insufficient balance for transfer",
 		},
+		{
+			name: "happy: balance override for transfer",
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				recipient := evmtest.NewEthPrivAcc().EthAddr
+				amountToSend := hexutil.Big(*evm.NativeToWei(big.NewInt(10)))
+
+				jsonTxArgs, err := json.Marshal(&evm.JsonTxArgs{
+					From:  &deps.Sender.EthAddr,
+					To:    &recipient,
+					Value: &amountToSend,
+				})
+				s.Require().NoError(err)
+				balance := (*hexutil.Big)(evm.NativeToWei(big.NewInt(1000)))
+				overrides, err := json.Marshal(evm.StateOverride{
+					deps.Sender.EthAddr: {Balance: &balance},
+				})
+				s.Require().NoError(err)
+				req = &evm.EthCallRequest{
+					Args:      jsonTxArgs,
+					GasCap:    gethparams.TxGas,
+					Overrides: overrides,
+				}
+				wantResp = &evm.EstimateGasResponse{
+					Gas: gethparams.TxGas,
+				}
+				return req, wantResp
+			},
+			wantErr: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -767,6 +960,279 @@ func (s *Suite) TestEstimateGasForEvmCallType() {
 	}
 }
 
+func (s *Suite) TestCreateAccessList() {
+	deps := evmtest.NewTestDeps()
+	deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().NoError(err)
+	contractAddr := deployResp.ContractAddr
+
+	newReq := func(input []byte) *evm.EthCallRequest {
+		jsonTxArgs, err := json.Marshal(&evm.JsonTxArgs{
+			From:  &deps.Sender.EthAddr,
+			To:    &contractAddr,
+			Input: (*hexutil.Bytes)(&input),
+		})
+		s.Require().NoError(err)
+		return &evm.EthCallRequest{Args: jsonTxArgs, GasCap: 100_000}
+	}
+
+	s.Run("sad: nil query", func() {
+		_, err := deps.EvmKeeper.CreateAccessList(deps.GoCtx(), nil)
+		s.Require().ErrorContains(err, "InvalidArgument")
+	})
+
+	s.Run("sad: invalid args", func() {
+		_, err := deps.EvmKeeper.CreateAccessList(
+			deps.GoCtx(), &evm.EthCallRequest{Args: []byte("invalid")},
+		)
+		s.Require().ErrorContains(err, "InvalidArgument")
+	})
+
+	s.Run("happy: storage slots of the called contract", func() {
+		input, err := embeds.SmartContract_TestERC20.ABI.Pack(
+			"transfer", evmtest.NewEthPrivAcc().EthAddr, big.NewInt(1),
+		)
+		s.Require().NoError(err)
+		resp, err := deps.EvmKeeper.CreateAccessList(deps.GoCtx(), newReq(input))
+		s.Require().NoError(err)
+		s.Empty(resp.VmError)
+		s.Greater(resp.GasUsed, gethparams.TxGas)
+
+		// The transfer reads and writes the balances of sender and recipient.
+		s.Require().Len(resp.AccessList, 1)
+		s.Equal(contractAddr.Hex(), resp.AccessList[0].Address)
+		s.Len(resp.AccessList[0].StorageKeys, 2)
+
+		// Sending the call with the returned access list must give the same
+		// access list back.
+		var args evm.JsonTxArgs
+		s.Require().NoError(json.Unmarshal(newReq(input).Args, &args))
+		args.AccessList = resp.AccessList.ToEthAccessList()
+		argsWithAccessList, err := json.Marshal(&args)
+		s.Require().NoError(err)
+		respAgain, err := deps.EvmKeeper.CreateAccessList(
+			deps.GoCtx(), &evm.EthCallRequest{Args: argsWithAccessList, GasCap: 100_000},
+		)
+		s.Require().NoError(err)
+		s.Equal(resp.AccessList, respAgain.AccessList)
+		s.Equal(resp.GasUsed, respAgain.GasUsed)
+	})
+
+	s.Run("happy: vm error is returned with the access list", func() {
+		input, err := embeds.SmartContract_TestERC20.ABI.Pack(
+			"transfer", evmtest.NewEthPrivAcc().EthAddr, new(big.Int).Lsh(big.NewInt(1), 200),
+		)
+		s.Require().NoError(err)
+		resp, err := deps.EvmKeeper.CreateAccessList(deps.GoCtx(), newReq(input))
+		s.Require().NoError(err)
+		s.NotEmpty(resp.VmError)
+		s.Require().Len(resp.AccessList, 1)
+		s.Equal(contractAddr.Hex(), resp.AccessList[0].Address)
+	})
+}
+
+func (s *Suite) TestSimulateV1() {
+	deps := evmtest.NewTestDeps()
+	deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().NoError(err)
+	contractAddr := deployResp.ContractAddr
+	erc20ABI := embeds.SmartContract_TestERC20.ABI
+	owner := deps.Sender.EthAddr
+	spender := evmtest.NewEthPrivAcc().EthAddr
+	recipient := evmtest.NewEthPrivAcc().EthAddr
+
+	call := func(from gethcommon.Address, method string, args ...any) evm.JsonTxArgs {
+		input, err := erc20ABI.Pack(method, args...)
+		s.Require().NoError(err)
+		return evm.JsonTxArgs{From: &from, To: &contractAddr, Input: (*hexutil.Bytes)(&input)}
+	}
+	simulate := func(opts evm.SimOpts) (*evm.SimulateV1Response, []evm.SimulatedBlock) {
+		bz, err := json.Marshal(&opts)
+		s.Require().NoError(err)
+		resp, err := deps.EvmKeeper.SimulateV1(
+			deps.GoCtx(), &evm.SimulateV1Request{Opts: bz, GasCap: 1_000_000},
+		)
+		s.Require().NoError(err)
+		var blocks []evm.SimulatedBlock
+		if resp.ErrorCode == 0 {
+			s.Require().NoError(json.Unmarshal(resp.Blocks, &blocks))
+		}
+		return resp, blocks
+	}
+	height := uint64(deps.Ctx.BlockHeight())
+	nonceBefore := deps.EvmKeeper.GetAccNonce(deps.Ctx, owner)
+
+	s.Run("sad: nil query", func() {
+		_, err := deps.EvmKeeper.SimulateV1(deps.GoCtx(), nil)
+		s.Require().ErrorContains(err, "InvalidArgument")
+	})
+
+	s.Run("happy: approve then transferFrom across blocks", func() {
+		_, blocks := simulate(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{
+				{Calls: []evm.JsonTxArgs{
+					call(owner, "approve", spender, big.NewInt(100)),
+					call(spender, "transferFrom", owner, recipient, big.NewInt(60)),
+				}},
+				{
+					BlockOverrides: &evm.BlockOverrides{Number: (*hexutil.Big)(new(big.Int).SetUint64(height + 4))},
+					Calls: []evm.JsonTxArgs{
+						call(recipient, "balanceOf", recipient),
+						call(spender, "transferFrom", owner, recipient, big.NewInt(60)),
+					},
+				},
+			},
+		})
+		// Blocks height+2 and height+3 are empty blocks filling the gap.
+		s.Require().Len(blocks, 4)
+		for i, block := range blocks {
+			s.Equal(height+1+uint64(i), block.Header.Number.Uint64())
+			if i > 0 {
+				s.Equal(blocks[i-1].Header.Hash(), block.Header.ParentHash)
+				s.Greater(block.Header.Time, blocks[i-1].Header.Time)
+			}
+		}
+		s.Empty(blocks[1].Calls)
+		s.Empty(blocks[2].Calls)
+
+		first := blocks[0]
+		s.Require().Len(first.Calls, 2)
+		s.Require().Len(first.Txs, 2)
+		// Log indexes are unique within the block.
+		logIndex := 0
+		for txIndex, callRes := range first.Calls {
+			s.Nil(callRes.Error)
+			s.EqualValues(1, callRes.Status)
+			s.Require().NotEmpty(callRes.Logs)
+			for _, log := range callRes.Logs {
+				s.Equal(contractAddr, log.Address)
+				s.EqualValues(logIndex, log.Index)
+				s.EqualValues(txIndex, log.TxIndex)
+				s.Equal(first.Header.Hash(), log.BlockHash)
+				s.Equal(first.Header.Number.Uint64(), log.BlockNumber)
+				logIndex++
+			}
+		}
+		s.Equal(spender, first.Txs[1].From)
+		s.EqualValues(uint64(first.Calls[0].GasUsed)+uint64(first.Calls[1].GasUsed), first.Header.GasUsed)
+
+		last := blocks[3]
+		s.Require().Len(last.Calls, 2)
+		s.Equal(gethcommon.BigToHash(big.NewInt(60)).Bytes(), []byte(last.Calls[0].ReturnData))
+		// The allowance left after the first block is 40.
+		s.EqualValues(0, last.Calls[1].Status)
+		s.Require().NotNil(last.Calls[1].Error)
+		s.Equal(evm.SimErrCodeExecutionReverted, last.Calls[1].Error.Code)
+		s.Contains(last.Calls[1].Error.Message, "execution reverted")
+		s.Empty(last.Calls[1].Logs)
+
+		// No state was committed.
+		s.Equal(nonceBefore, deps.EvmKeeper.GetAccNonce(deps.Ctx, owner))
+	})
+
+	s.Run("happy: trace transfers with a state override", func() {
+		from := evmtest.NewEthPrivAcc().EthAddr
+		to := evmtest.NewEthPrivAcc().EthAddr
+		balance := (*hexutil.Big)(evm.NativeToWei(big.NewInt(10)))
+		value := (*hexutil.Big)(evm.NativeToWei(big.NewInt(3)))
+		_, blocks := simulate(evm.SimOpts{
+			TraceTransfers: true,
+			BlockStateCalls: []evm.SimBlock{{
+				StateOverrides: &evm.StateOverride{from: {Balance: &balance}},
+				Calls:          []evm.JsonTxArgs{{From: &from, To: &to, Value: value}},
+			}},
+		})
+		s.Require().Len(blocks, 1)
+		s.Require().Len(blocks[0].Calls, 1)
+		logs := blocks[0].Calls[0].Logs
+		s.Require().Len(logs, 1)
+		s.Equal(evm.SimTransferLogAddress, logs[0].Address)
+		s.Equal(gethcommon.BytesToHash(from.Bytes()), logs[0].Topics[1])
+		s.Equal(gethcommon.BytesToHash(to.Bytes()), logs[0].Topics[2])
+		s.Equal(value.ToInt(), new(big.Int).SetBytes(logs[0].Data))
+	})
+
+	s.Run("happy: the Bank keeper StateDB is left as is", func() {
+		stateDBBefore := deps.EvmKeeper.Bank.StateDB
+		_, blocks := simulate(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{{
+				Calls: []evm.JsonTxArgs{call(owner, "approve", spender, big.NewInt(100))},
+			}},
+		})
+		s.Require().Len(blocks, 1)
+		s.Same(stateDBBefore, deps.EvmKeeper.Bank.StateDB)
+	})
+
+	s.Run("sad: block numbers out of order", func() {
+		resp, _ := simulate(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{
+				{BlockOverrides: &evm.BlockOverrides{Number: (*hexutil.Big)(new(big.Int).SetUint64(height + 2))}},
+				{BlockOverrides: &evm.BlockOverrides{Number: (*hexutil.Big)(new(big.Int).SetUint64(height + 2))}},
+			},
+		})
+		s.EqualValues(evm.SimErrCodeBlockNumberOrder, resp.ErrorCode)
+	})
+
+	s.Run("sad: validation of the nonce", func() {
+		args := call(owner, "approve", spender, big.NewInt(100))
+		nonce := hexutil.Uint64(nonceBefore + 1)
+		args.Nonce = &nonce
+		resp, _ := simulate(evm.SimOpts{
+			Validation:      true,
+			BlockStateCalls: []evm.SimBlock{{Calls: []evm.JsonTxArgs{args}}},
+		})
+		s.EqualValues(evm.SimErrCodeNonceTooHigh, resp.ErrorCode)
+		s.Contains(resp.Error, "nonce too high")
+	})
+
+	s.Run("sad: the gas cap is a budget for all the calls", func() {
+		// Each call fits the gas cap, but not all of them together.
+		var calls []evm.JsonTxArgs
+		for i := 0; i < 60; i++ {
+			calls = append(calls, call(owner, "balanceOf", owner))
+		}
+		resp, _ := simulate(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{{Calls: calls[:30]}, {Calls: calls[30:]}},
+		})
+		s.EqualValues(evm.SimErrCodeClientLimit, resp.ErrorCode)
+		s.Contains(resp.Error, "gas cap reached")
+
+		_, blocks := simulate(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{{Calls: calls[:10]}, {Calls: calls[10:20]}},
+		})
+		s.Require().Len(blocks, 2)
+	})
+
+	s.Run("sad: too many calls", func() {
+		calls := make([]evm.JsonTxArgs, evm.MaxSimulateCalls+1)
+		for i := range calls {
+			calls[i] = call(owner, "balanceOf", owner)
+		}
+		resp, _ := simulate(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{{Calls: calls}},
+		})
+		s.EqualValues(evm.SimErrCodeClientLimit, resp.ErrorCode)
+		s.Contains(resp.Error, "too many calls")
+	})
+
+	s.Run("sad: chain ID of another chain", func() {
+		bz, err := json.Marshal(&evm.SimOpts{})
+		s.Require().NoError(err)
+		_, err = deps.EvmKeeper.SimulateV1(
+			deps.GoCtx(), &evm.SimulateV1Request{Opts: bz, ChainId: 1},
+		)
+		s.Require().ErrorContains(err, "invalid chain ID 1")
+
+		resp, err := deps.EvmKeeper.SimulateV1(
+			deps.GoCtx(), &evm.SimulateV1Request{
+				Opts: bz, ChainId: deps.EvmKeeper.EthChainID(deps.Ctx).Int64(),
+			},
+		)
+		s.Require().NoError(err)
+		s.Zero(resp.ErrorCode)
+	})
+}
+
 func (s *Suite) TestTraceTx() {
 	type In = *evm.QueryTraceTxRequest
 	type Out = string
@@ -15,13 +15,16 @@ import (
 // BeginBlock hook for the EVM module.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
+// EndBlock retrieves the bloom filter value from the transient store and
+// emits it as an event. It then updates the EIP-1559 base fee for the next
+// block from the gas used by Ethereum txs in this block.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 	bloom := gethcoretypes.BytesToBloom(k.EvmState.GetBlockBloomTransient(ctx).Bytes())
 	_ = ctx.EventManager().EmitTypedEvent(&evm.EventBlockBloom{
 		Bloom: eth.BloomToHex(bloom),
 	})
+	k.UpdateBaseFee(ctx)
 	// The bloom logic doesn't update the validator set.
 	return []abci.ValidatorUpdate{}
 }
@@ -115,18 +115,49 @@ func (k *Keeper) AddToBlockGasUsed(
 	return blockGasUsed, nil
 }
 
-// BaseFeeMicronibiPerGas returns the gas base fee in units of the EVM denom. Note
-// that this function is currently constant/stateless.
-func (k Keeper) BaseFeeMicronibiPerGas(_ sdk.Context) *big.Int {
-	// TODO: (someday maybe):  Consider making base fee dynamic based on
-	// congestion in the previous block.
-	return evm.BASE_FEE_MICRONIBI
+// addToBlockEthGasUsed accumulates the gas used by an Ethereum tx into the
+// total for the block, which sets the base fee of the next block.
+func (k *Keeper) addToBlockEthGasUsed(ctx sdk.Context, gasUsed uint64) (err error) {
+	defer HandleOutOfGasPanic(&err, "")
+
+	blockEthGasUsed := k.EvmState.BlockEthGasUsed.GetOr(ctx, 0) + gasUsed
+	if blockEthGasUsed < gasUsed {
+		return errors.Wrap(core.ErrGasUintOverflow, "transient block gas used")
+	}
+	k.EvmState.BlockEthGasUsed.Set(ctx, blockEthGasUsed)
+	return nil
+}
+
+// BaseFeeMicronibiPerGas returns the gas base fee of the current block in
+// units of the EVM denom, rounded down to a whole micronibi. Fees are charged
+// with the exact base fee from [Keeper.BaseFeeWeiPerGas].
+func (k Keeper) BaseFeeMicronibiPerGas(ctx sdk.Context) *big.Int {
+	return evm.WeiToNative(k.BaseFeeWeiPerGas(ctx))
 }
 
-// BaseFeeWeiPerGas is the same as BaseFeeMicronibiPerGas, except its in units of
-// wei per gas.
-func (k Keeper) BaseFeeWeiPerGas(_ sdk.Context) *big.Int {
-	return evm.NativeToWei(k.BaseFeeMicronibiPerGas(sdk.Context{}))
+// BaseFeeWeiPerGas returns the gas base fee of the current block in units of
+// wei per gas. The base fee follows EIP-1559, adjusting at the end of each
+// block to the gas used by its Ethereum txs (see [Keeper.UpdateBaseFee]), and
+// is never below the "min_base_fee" param.
+func (k Keeper) BaseFeeWeiPerGas(ctx sdk.Context) *big.Int {
+	return k.baseFeeWei(ctx)
+}
+
+// baseFeeWei returns the stored base fee in units of wei per gas, bounded
+// below by the "min_base_fee" param. Reading the base fee is part of the
+// protocol rather than the tx, so it doesn't consume gas.
+func (k Keeper) baseFeeWei(ctx sdk.Context) *big.Int {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	params := k.GetParams(ctx)
+	minBaseFeeWei := evm.NativeToWei(params.MinBaseFeeMicronibi())
+	if !params.IsDynamicBaseFee() {
+		return minBaseFeeWei
+	}
+	baseFeeWei, err := k.EvmState.BaseFee.Get(ctx)
+	if err != nil || baseFeeWei.BigInt().Cmp(minBaseFeeWei) < 0 {
+		return minBaseFeeWei
+	}
+	return baseFeeWei.BigInt()
 }
 
 // Logger returns a module-specific logger.
@@ -79,6 +79,9 @@ func (k *Keeper) EthereumTx(
 	if err != nil {
 		return nil, errors.Wrap(err, "EthereumTx: error adding transient gas used to block")
 	}
+	if err = k.addToBlockEthGasUsed(ctx, evmResp.GasUsed); err != nil {
+		return nil, errors.Wrap(err, "EthereumTx: error adding transient gas used to block")
+	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the
 	// default SDK one.
@@ -629,6 +632,141 @@ func (k Keeper) convertCoinToEvmBornERC20(
 	return &evm.MsgConvertCoinToEvmResponse{}, nil
 }
 
+// ConvertEvmToCoin Sends ERC20 tokens with a valid "FunToken" mapping from the
+// Ethereum address of the sender to the given recipient address
+// ("to_bank_addr") in the corresponding Bank Coin representation.
+func (k *Keeper) ConvertEvmToCoin(
+	goCtx context.Context, msg *evm.MsgConvertEvmToCoin,
+) (resp *evm.MsgConvertEvmToCoinResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	toAddr := sdk.MustAccAddressFromBech32(msg.ToBankAddr)
+
+	erc20Addr := msg.Erc20Addr.Address
+	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20Addr))
+	if len(funTokens) == 0 {
+		return nil, fmt.Errorf("funtoken for ERC20 \"%s\" does not exist", erc20Addr.Hex())
+	}
+	if len(funTokens) > 1 {
+		return nil, fmt.Errorf("multiple funtokens for ERC20 \"%s\" found", erc20Addr.Hex())
+	}
+
+	fungibleTokenMapping := funTokens[0]
+
+	if fungibleTokenMapping.IsMadeFromCoin {
+		return k.convertEvmToCoinBornCoin(
+			ctx, sender, toAddr, msg.Amount, fungibleTokenMapping,
+		)
+	} else {
+		return k.convertEvmToCoinBornERC20(
+			ctx, sender, toAddr, msg.Amount, fungibleTokenMapping,
+		)
+	}
+}
+
+// Converts ERC20 tokens for FunToken mapping that was born from a coin
+// (IsMadeFromCoin=true) back into the Bank Coins. EVM module owns the ERC-20
+// contract and burns the ERC-20 tokens. The Bank Coins were escrowed in the EVM
+// module in the preceding BC → ERC20 conversion.
+func (k Keeper) convertEvmToCoinBornCoin(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	recipient sdk.AccAddress,
+	amount math.Int,
+	funTokenMapping evm.FunToken,
+) (*evm.MsgConvertEvmToCoinResponse, error) {
+	erc20Addr := funTokenMapping.Erc20Addr.Address
+
+	// 1 | Sender transfers ERC20 tokens to the EVM module
+	actualSentAmount, _, err := k.ERC20().Transfer(
+		erc20Addr,
+		eth.NibiruAddrToEthAddr(sender),
+		evm.EVM_MODULE_ADDRESS,
+		amount.BigInt(),
+		ctx,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to transfer ERC-20 tokens")
+	}
+
+	// 2 | EVM module burns the ERC20 tokens to preserve an invariant on the sum
+	// of the FunToken's bank and ERC20 supply.
+	evmResp, err := k.ERC20().Burn(erc20Addr, evm.EVM_MODULE_ADDRESS, actualSentAmount, ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to burn ERC-20 tokens")
+	}
+	if evmResp.Failed() {
+		return nil,
+			fmt.Errorf("failed to burn erc-20 tokens of contract %s", erc20Addr.String())
+	}
+
+	// 3 | EVM module sends the escrowed Bank Coins to the recipient
+	coin := sdk.NewCoin(funTokenMapping.BankDenom, sdk.NewIntFromBigInt(actualSentAmount))
+	err = k.Bank.SendCoinsFromModuleToAccount(ctx, evm.ModuleName, recipient, sdk.NewCoins(coin))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send coins from module account")
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertEvmToCoin{
+		Sender:               sender.String(),
+		Erc20ContractAddress: erc20Addr.String(),
+		ToBankAddr:           recipient.String(),
+		BankCoin:             coin,
+	})
+
+	return &evm.MsgConvertEvmToCoinResponse{}, nil
+}
+
+// Converts ERC20 tokens for FunToken mapping that was born from an ERC20
+// (IsMadeFromCoin=false) into Bank Coins. EVM module does not own the ERC-20
+// contract, so the ERC-20 tokens are escrowed in the EVM module and the Bank
+// Coins are minted.
+func (k Keeper) convertEvmToCoinBornERC20(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	recipient sdk.AccAddress,
+	amount math.Int,
+	funTokenMapping evm.FunToken,
+) (*evm.MsgConvertEvmToCoinResponse, error) {
+	erc20Addr := funTokenMapping.Erc20Addr.Address
+
+	// 1 | Sender transfers ERC20 tokens to the EVM module, where they stay in
+	// escrow until the Bank Coins are converted back to ERC20.
+	actualSentAmount, _, err := k.ERC20().Transfer(
+		erc20Addr,
+		eth.NibiruAddrToEthAddr(sender),
+		evm.EVM_MODULE_ADDRESS,
+		amount.BigInt(),
+		ctx,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to transfer ERC-20 tokens")
+	}
+
+	// 2 | EVM module mints the Bank Coins for the amount actually received,
+	// which may be less than "amount" if the ERC20 has a fee on transfer.
+	coin := sdk.NewCoin(funTokenMapping.BankDenom, sdk.NewIntFromBigInt(actualSentAmount))
+	if err := k.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return nil, errors.Wrap(err, "failed to mint coins")
+	}
+
+	// 3 | EVM module sends the Bank Coins to the recipient
+	err = k.Bank.SendCoinsFromModuleToAccount(ctx, evm.ModuleName, recipient, sdk.NewCoins(coin))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send coins from module account")
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertEvmToCoin{
+		Sender:               sender.String(),
+		Erc20ContractAddress: erc20Addr.String(),
+		ToBankAddr:           recipient.String(),
+		BankCoin:             coin,
+	})
+
+	return &evm.MsgConvertEvmToCoinResponse{}, nil
+}
+
 // EmitEthereumTxEvents emits all types of EVM events applicable to a particular execution case
 func (k *Keeper) EmitEthereumTxEvents(
 	ctx sdk.Context,
@@ -0,0 +1,491 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+)
+
+// SimulateV1: Implements the gRPC query for "/eth.evm.v1.Query/SimulateV1".
+// SimulateV1 implements the "eth_simulateV1" rpc api: it runs ordered batches
+// of calls in a sequence of simulated blocks on top of the queried block. Each
+// block applies its block and state overrides before its calls, and every call
+// sees the state left by the previous ones. Everything runs against a cached
+// context, so no state is committed.
+//
+// As in geth, the gas cap of the request is a budget for all of its calls:
+// the gas used by each call is subtracted from it, and the simulation is
+// aborted once it runs out.
+//
+// A simulation aborted by an invalid block or tx, for example a nonce that
+// fails validation, isn't a gRPC error: its JSON-RPC error code and message
+// are set in the response instead.
+func (k *Keeper) SimulateV1(
+	goCtx context.Context, req *evm.SimulateV1Request,
+) (*evm.SimulateV1Response, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var opts evm.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	chainID := k.EthChainID(ctx)
+	if req.ChainId != 0 && req.ChainId != chainID.Int64() {
+		return nil, grpcstatus.Errorf(
+			grpccodes.InvalidArgument, "invalid chain ID %d, expected %s", req.ChainId, chainID,
+		)
+	}
+	cfg, err := k.GetEVMConfig(ctx, ParseProposerAddr(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	sim := &simulator{
+		k:         k,
+		opts:      opts,
+		cfg:       cfg,
+		gasCap:    req.GasCap,
+		gasBudget: req.GasCap,
+		chainID:   chainID,
+	}
+	if sim.gasBudget == 0 {
+		sim.gasBudget = math.MaxUint64
+	}
+	blocks, err := sim.run(cacheCtx)
+	var simErr *evm.SimError
+	if errors.As(err, &simErr) {
+		return &evm.SimulateV1Response{
+			ErrorCode: int64(simErr.Code),
+			Error:     simErr.Message,
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return &evm.SimulateV1Response{Blocks: bz}, nil
+}
+
+// simulator runs the blocks of an "eth_simulateV1" request.
+type simulator struct {
+	k      *Keeper
+	opts   evm.SimOpts
+	cfg    *statedb.EVMConfig
+	gasCap uint64
+	// gasBudget is the gas left for the calls of the request, out of the gas
+	// cap.
+	gasBudget uint64
+	chainID   *big.Int
+}
+
+// run simulates the blocks of the request on top of the block of "ctx". The
+// gaps between the numbers of consecutive blocks are filled with empty blocks.
+func (sim *simulator) run(ctx sdk.Context) ([]evm.SimulatedBlock, error) {
+	if len(sim.opts.BlockStateCalls) > evm.MaxSimulateBlocks {
+		return nil, evm.NewSimError(
+			evm.SimErrCodeClientLimit, "too many blocks: at most %d blocks can be simulated", evm.MaxSimulateBlocks,
+		)
+	}
+	numCalls := 0
+	for _, simBlock := range sim.opts.BlockStateCalls {
+		numCalls += len(simBlock.Calls)
+	}
+	if numCalls > evm.MaxSimulateCalls {
+		return nil, evm.NewSimError(
+			evm.SimErrCodeClientLimit, "too many calls: at most %d calls can be simulated", evm.MaxSimulateCalls,
+		)
+	}
+
+	parentNumber := uint64(ctx.BlockHeight())
+	parentTime := uint64(ctx.BlockTime().Unix())
+	parentHash := sim.k.GetHashFn(ctx)(parentNumber)
+
+	var blocks []evm.SimulatedBlock
+	for _, simBlock := range sim.opts.BlockStateCalls {
+		overrides := simBlock.BlockOverrides
+		if overrides == nil {
+			overrides = new(evm.BlockOverrides)
+		}
+
+		number := parentNumber + 1
+		if overrides.Number != nil {
+			n := overrides.Number.ToInt()
+			if !n.IsUint64() || n.Uint64() <= parentNumber {
+				return nil, evm.NewSimError(
+					evm.SimErrCodeBlockNumberOrder,
+					"block numbers must be in order: %s <= %d", n, parentNumber,
+				)
+			}
+			number = n.Uint64()
+		}
+		if number-parentNumber+uint64(len(blocks)) > evm.MaxSimulateBlocks {
+			return nil, evm.NewSimError(
+				evm.SimErrCodeClientLimit, "too many blocks: at most %d blocks can be simulated", evm.MaxSimulateBlocks,
+			)
+		}
+
+		// Fill the gap with empty blocks.
+		for parentNumber+1 < number {
+			block, err := sim.simulateBlock(ctx, evm.SimBlock{}, parentNumber+1, parentTime+evm.SimulateTimestampIncrement, parentHash)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, *block)
+			parentNumber, parentTime, parentHash = block.Header.Number.Uint64(), block.Header.Time, block.Header.Hash()
+		}
+
+		blockTime := parentTime + evm.SimulateTimestampIncrement
+		if overrides.Time != nil {
+			blockTime = uint64(*overrides.Time)
+			if blockTime <= parentTime {
+				return nil, evm.NewSimError(
+					evm.SimErrCodeBlockTimestamp,
+					"block timestamps must be in order: %d <= %d", blockTime, parentTime,
+				)
+			}
+		}
+
+		var err error
+		if simBlock.StateOverrides != nil {
+			ctx, err = sim.k.applyStateOverride(ctx, *simBlock.StateOverrides)
+			if err != nil {
+				return nil, evm.NewSimError(evm.SimErrCodeInvalidParams, "invalid state overrides: %s", err)
+			}
+		}
+
+		block, err := sim.simulateBlock(ctx, simBlock, number, blockTime, parentHash)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, *block)
+		parentNumber, parentTime, parentHash = number, blockTime, block.Header.Hash()
+	}
+	return blocks, nil
+}
+
+// simulateBlock runs the calls of a block with the given number and timestamp
+// and builds its header. The state changes of the calls are written to the
+// store of "ctx".
+func (sim *simulator) simulateBlock(
+	ctx sdk.Context,
+	simBlock evm.SimBlock,
+	number, blockTime uint64,
+	parentHash gethcommon.Hash,
+) (*evm.SimulatedBlock, error) {
+	overrides := simBlock.BlockOverrides
+	if overrides == nil {
+		overrides = new(evm.BlockOverrides)
+	}
+
+	gasLimit := eth.BlockGasLimit(ctx)
+	if gasLimit == 0 || gasLimit > math.MaxInt64 {
+		// The chain has no block gas limit. Use the gas cap so that the header
+		// holds a value JavaScript clients can parse.
+		gasLimit = sim.gasCap
+		if gasLimit == 0 {
+			gasLimit = math.MaxInt64
+		}
+	}
+	if overrides.GasLimit != nil {
+		gasLimit = uint64(*overrides.GasLimit)
+	}
+
+	cfg := *sim.cfg
+	if overrides.FeeRecipient != nil {
+		cfg.BlockCoinbase = *overrides.FeeRecipient
+	}
+	// Without validation, the calls don't pay for gas, as with "eth_call".
+	cfg.BaseFeeWei = big.NewInt(0)
+	if sim.opts.Validation {
+		cfg.BaseFeeWei = sim.cfg.BaseFeeWei
+	}
+	if overrides.BaseFeePerGas != nil {
+		cfg.BaseFeeWei = overrides.BaseFeePerGas.ToInt()
+	}
+
+	ctx = ctx.
+		WithBlockHeight(int64(number)).
+		WithBlockTime(time.Unix(int64(blockTime), 0).UTC()).
+		WithBlockGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	var (
+		txs      gethcore.Transactions
+		simTxs   = []evm.SimulatedTx{}
+		calls    = []evm.SimCallResult{}
+		receipts gethcore.Receipts
+		allLogs  []*gethcore.Log
+		gasUsed  uint64
+	)
+	for i, args := range simBlock.Calls {
+		tx, from, result, err := sim.simulateCall(ctx, &cfg, args, uint(i), uint(len(allLogs)), gasLimit-gasUsed)
+		if err != nil {
+			return nil, err
+		}
+		gasUsed += uint64(result.GasUsed)
+
+		txBz, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+		}
+		txs = append(txs, tx)
+		simTxs = append(simTxs, evm.SimulatedTx{Tx: txBz, From: from})
+		calls = append(calls, *result)
+		allLogs = append(allLogs, result.Logs...)
+
+		receipt := &gethcore.Receipt{
+			Type:              tx.Type(),
+			Status:            uint64(result.Status),
+			CumulativeGasUsed: gasUsed,
+			Logs:              result.Logs,
+			TxHash:            tx.Hash(),
+			GasUsed:           uint64(result.GasUsed),
+		}
+		receipt.Bloom = gethcore.CreateBloom(gethcore.Receipts{receipt})
+		receipts = append(receipts, receipt)
+	}
+
+	ethHeader := &gethcore.Header{
+		ParentHash:  parentHash,
+		UncleHash:   gethcore.EmptyUncleHash,
+		Coinbase:    cfg.BlockCoinbase,
+		TxHash:      gethcore.DeriveSha(txs, trie.NewStackTrie(nil)),
+		ReceiptHash: gethcore.DeriveSha(receipts, trie.NewStackTrie(nil)),
+		Bloom:       gethcore.CreateBloom(receipts),
+		Difficulty:  big.NewInt(0),
+		Number:      new(big.Int).SetUint64(number),
+		GasLimit:    gasLimit,
+		GasUsed:     gasUsed,
+		Time:        blockTime,
+		Extra:       []byte{},
+		BaseFee:     cfg.BaseFeeWei,
+	}
+	blockHash := ethHeader.Hash()
+	for _, log := range allLogs {
+		log.BlockHash = blockHash
+		log.BlockNumber = number
+	}
+
+	return &evm.SimulatedBlock{
+		Header: ethHeader,
+		Txs:    simTxs,
+		Calls:  calls,
+	}, nil
+}
+
+// simulateCall runs a call of a simulated block. "gasLeft" is the gas left in
+// the block. The gas of the call is capped by the gas budget of the request,
+// which is charged with the gas used. Returns the unsigned tx built from the
+// call, its sender and the result of the call.
+func (sim *simulator) simulateCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	args evm.JsonTxArgs,
+	txIndex, logIndex uint,
+	gasLeft uint64,
+) (*gethcore.Transaction, gethcommon.Address, *evm.SimCallResult, error) {
+	from := args.GetFrom()
+	stateNonce := sim.k.GetAccNonce(ctx, from)
+	if args.Nonce == nil {
+		args.Nonce = (*hexutil.Uint64)(&stateNonce)
+	} else if sim.opts.Validation {
+		switch nonce := uint64(*args.Nonce); {
+		case nonce < stateNonce:
+			return nil, from, nil, evm.NewSimError(
+				evm.SimErrCodeNonceTooLow,
+				"nonce too low: address %s, tx: %d state: %d", from.Hex(), nonce, stateNonce,
+			)
+		case nonce > stateNonce:
+			return nil, from, nil, evm.NewSimError(
+				evm.SimErrCodeNonceTooHigh,
+				"nonce too high: address %s, tx: %d state: %d", from.Hex(), nonce, stateNonce,
+			)
+		}
+	}
+
+	errGasCapReached := evm.NewSimError(
+		evm.SimErrCodeClientLimit,
+		"gas cap reached: the calls of the request used the gas cap %d", sim.gasCap,
+	)
+	if sim.gasBudget == 0 {
+		return nil, from, nil, errGasCapReached
+	}
+	cappedByBudget := false
+	if args.Gas == nil {
+		gas := min(gasLeft, sim.gasBudget)
+		cappedByBudget = gas < gasLeft
+		args.Gas = (*hexutil.Uint64)(&gas)
+	} else if uint64(*args.Gas) > gasLeft {
+		return nil, from, nil, evm.NewSimError(
+			evm.SimErrCodeBlockGasLimit,
+			"block gas limit reached: tx gas %d > gas left in block %d", uint64(*args.Gas), gasLeft,
+		)
+	} else if uint64(*args.Gas) > sim.gasBudget {
+		// Like geth, cap the gas of the call rather than failing it.
+		gas := sim.gasBudget
+		cappedByBudget = true
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
+	if args.ChainID == nil {
+		args.ChainID = (*hexutil.Big)(sim.chainID)
+	}
+
+	msg, err := args.ToMessage(0, cfg.BaseFeeWei)
+	if err != nil {
+		return nil, from, nil, evm.NewSimError(evm.SimErrCodeInvalidParams, err.Error())
+	}
+	tx := args.ToMsgEthTx().AsTransaction()
+
+	if sim.opts.Validation {
+		if cfg.BaseFeeWei.Sign() > 0 && msg.GasFeeCap().Cmp(cfg.BaseFeeWei) < 0 {
+			return nil, from, nil, evm.NewSimError(
+				evm.SimErrCodeBaseFeeTooLow,
+				"max fee per gas less than block base fee: address %s, maxFeePerGas: %s, baseFee: %s",
+				from.Hex(), msg.GasFeeCap(), cfg.BaseFeeWei,
+			)
+		}
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasFeeCap())
+		cost.Add(cost, msg.Value())
+		if balance := sim.k.GetEvmGasBalance(ctx, from); balance.Cmp(cost) < 0 {
+			return nil, from, nil, evm.NewSimError(
+				evm.SimErrCodeInsufficientFunds,
+				"insufficient funds for gas * price + value: address %s have %s want %s",
+				from.Hex(), balance, cost,
+			)
+		}
+	}
+
+	txConfig := statedb.TxConfig{
+		TxHash:   tx.Hash(),
+		TxIndex:  txIndex,
+		LogIndex: logIndex,
+	}
+	var tracer vm.EVMLogger
+	if sim.opts.TraceTransfers {
+		tracer = &transferTracer{}
+	}
+	res, _, err := sim.k.ApplyEvmMsg(ctx, msg, tracer, true, cfg, txConfig, false)
+	if errors.Is(err, core.ErrIntrinsicGas) && cappedByBudget {
+		return nil, from, nil, errGasCapReached
+	} else if errors.Is(err, core.ErrIntrinsicGas) {
+		return nil, from, nil, evm.NewSimError(evm.SimErrCodeIntrinsicGas, err.Error())
+	} else if err != nil {
+		return nil, from, nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	sim.gasBudget -= min(res.GasUsed, sim.gasBudget)
+
+	// Calls don't go through the ante handler, which increments the nonce and
+	// deducts the gas fees. statedb.New leaves the Bank keeper StateDB as
+	// ApplyEvmMsg restored it.
+	db := statedb.New(ctx, sim.k, txConfig)
+	if msg.To() != nil {
+		db.SetNonce(from, msg.Nonce()+1)
+	}
+	if sim.opts.Validation {
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(res.GasUsed), msg.GasPrice())
+		db.SubBalance(from, fee)
+	}
+	if err := db.Commit(); err != nil {
+		return nil, from, nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	result := &evm.SimCallResult{
+		ReturnData: res.Ret,
+		Logs:       evm.LogsToEthereum(res.Logs),
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Status:     hexutil.Uint64(gethcore.ReceiptStatusSuccessful),
+	}
+	if result.Logs == nil {
+		result.Logs = []*gethcore.Log{}
+	}
+	if res.Failed() {
+		result.Status = hexutil.Uint64(gethcore.ReceiptStatusFailed)
+		result.Error = &evm.SimCallError{
+			Code:    evm.SimErrCodeVMError,
+			Message: res.VmError,
+		}
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			result.Error.Code = evm.SimErrCodeExecutionReverted
+			if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+				result.Error.Message += ": " + reason
+			}
+			result.Error.Data = hexutil.Encode(res.Ret)
+		}
+	}
+	return tx, from, result, nil
+}
+
+// transferTopic is the topic of the ERC20 "Transfer(address,address,uint256)"
+// event.
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// transferTracer adds a log emitted by [evm.SimTransferLogAddress] to the
+// state for every transfer of ether, like the "Transfer" event of an ERC20
+// token. The logs are reverted with the call frames that made the transfers.
+type transferTracer struct {
+	evm.NoOpTracer
+	env *vm.EVM
+}
+
+var _ vm.EVMLogger = (*transferTracer)(nil)
+
+func (t *transferTracer) CaptureStart(
+	env *vm.EVM, from, to gethcommon.Address, _ bool, _ []byte, _ uint64, value *big.Int,
+) {
+	t.env = env
+	t.captureTransfer(from, to, value)
+}
+
+func (t *transferTracer) CaptureEnter(
+	typ vm.OpCode, from, to gethcommon.Address, _ []byte, _ uint64, value *big.Int,
+) {
+	// A delegate call runs with the value of its parent call, which was
+	// already transferred.
+	if typ == vm.DELEGATECALL {
+		return
+	}
+	t.captureTransfer(from, to, value)
+}
+
+func (t *transferTracer) captureTransfer(from, to gethcommon.Address, value *big.Int) {
+	if t.env == nil || value == nil || value.Sign() <= 0 {
+		return
+	}
+	t.env.StateDB.AddLog(&gethcore.Log{
+		Address: evm.SimTransferLogAddress,
+		Topics: []gethcommon.Hash{
+			transferTopic,
+			gethcommon.BytesToHash(from.Bytes()),
+			gethcommon.BytesToHash(to.Bytes()),
+		},
+		Data: gethcommon.BigToHash(value).Bytes(),
+	})
+}
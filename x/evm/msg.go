@@ -537,3 +537,31 @@ func (m *MsgConvertCoinToEvm) ValidateBasic() error {
 func (m MsgConvertCoinToEvm) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgConvertEvmToCoin message.
+func (m MsgConvertEvmToCoin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgConvertEvmToCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ToBankAddr); err != nil {
+		return fmt.Errorf("invalid to_bank_addr")
+	}
+	if m.Erc20Addr.Address == (common.Address{}) {
+		return fmt.Errorf("empty erc20_addr")
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertEvmToCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
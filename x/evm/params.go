@@ -3,6 +3,7 @@ package evm
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -28,9 +29,15 @@ const (
 func DefaultParams() Params {
 	return Params{
 		ExtraEIPs: []int64{},
-		// EVMChannels: Unused but intended for use with future IBC functionality
+		// EVMChannels: Channels over which the IBC transfer precompile
+		// ("IIBCTransfer.sol") is allowed to send ICS-20 transfers.
 		EVMChannels:       []string{},
 		CreateFuntokenFee: math.NewIntWithDecimal(10_000, 6), // 10_000 NIBI
+		// EIP-1559 defaults: The base fee changes by at most 12.5% per block,
+		// and the gas target is half of the block gas limit.
+		BaseFeeChangeDenominator:    8,
+		BaseFeeElasticityMultiplier: 2,
+		MinBaseFee:                  math.NewIntFromBigInt(BASE_FEE_MICRONIBI),
 	}
 }
 
@@ -58,7 +65,32 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateChannels(p.EVMChannels)
+	if err := validateChannels(p.EVMChannels); err != nil {
+		return err
+	}
+
+	if p.BaseFeeChangeDenominator != 0 && p.BaseFeeElasticityMultiplier == 0 {
+		return fmt.Errorf("base fee elasticity multiplier must be positive when the dynamic base fee is enabled")
+	}
+	if !p.MinBaseFee.IsNil() && p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", p.MinBaseFee)
+	}
+	return nil
+}
+
+// MinBaseFeeMicronibi returns the floor of the base fee in units of
+// "evm_denom" per gas. It defaults to [BASE_FEE_MICRONIBI] if unset.
+func (p Params) MinBaseFeeMicronibi() *big.Int {
+	if p.MinBaseFee.IsNil() {
+		return BASE_FEE_MICRONIBI
+	}
+	return p.MinBaseFee.BigInt()
+}
+
+// IsDynamicBaseFee returns true if the base fee adjusts to block gas usage
+// following EIP-1559.
+func (p Params) IsDynamicBaseFee() bool {
+	return p.BaseFeeChangeDenominator != 0 && p.BaseFeeElasticityMultiplier != 0
 }
 
 // EIPs returns the ExtraEIPS as a int slice
@@ -0,0 +1,361 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+var _ vm.PrecompiledContract = (*precompileBank)(nil)
+
+// Precompile address for "IBank.sol", the contract that enables transfers and
+// queries of native bank coins, including coins without a FunToken mapping.
+var PrecompileAddr_Bank = gethcommon.HexToAddress("0x0000000000000000000000000000000000000805")
+
+func (p precompileBank) Address() gethcommon.Address {
+	return PrecompileAddr_Bank
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileBank) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileBank) ABI() *gethabi.ABI {
+	return embeds.SmartContract_Bank.ABI
+}
+
+const (
+	BankMethod_send          PrecompileMethod = "send"
+	BankMethod_balanceOf     PrecompileMethod = "balanceOf"
+	BankMethod_allBalances   PrecompileMethod = "allBalances"
+	BankMethod_totalSupply   PrecompileMethod = "totalSupply"
+	BankMethod_denomMetadata PrecompileMethod = "denomMetadata"
+)
+
+// Run runs the precompiled contract
+func (p precompileBank) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	return runPrecompile(p, evm, contract, readonly, p.evmKeeper.Bank, map[PrecompileMethod]precompileMethod{
+		BankMethod_send:          p.send,
+		BankMethod_balanceOf:     p.balanceOf,
+		BankMethod_allBalances:   p.allBalances,
+		BankMethod_totalSupply:   p.totalSupply,
+		BankMethod_denomMetadata: p.denomMetadata,
+	})
+}
+
+func PrecompileBank(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileBank{
+		evmKeeper: keepers.EvmKeeper,
+	}
+}
+
+type precompileBank struct {
+	evmKeeper *evmkeeper.Keeper
+}
+
+// send: Implements "IBank.send"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function send(
+//	    string memory to,
+//	    string memory denom,
+//	    uint256 amount
+//	) external returns (bool success);
+//	```
+//
+// The transfer goes through the bank "MsgSend" handler of the
+// [evmkeeper.NibiruBankKeeper], so send-enabled denoms and blocked module
+// addresses are respected and the StateDB stays in sync when "unibi" moves.
+func (p precompileBank) send(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	toAddr, coin, err := p.parseArgsSend(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	msg := &banktypes.MsgSend{
+		FromAddress: eth.EthAddrToNibiruAddr(caller).String(),
+		ToAddress:   toAddr.String(),
+		Amount:      sdk.NewCoins(coin),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	_, err = bankkeeper.NewMsgServerImpl(p.evmKeeper.Bank).Send(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "Send",
+		caller, msg.ToAddress, coin.Denom, coin.Amount.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+func (p precompileBank) parseArgsSend(args []any) (
+	toAddr sdk.AccAddress,
+	coin sdk.Coin,
+	err error,
+) {
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	to, ok := args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string to", args[argIdx])
+		return
+	}
+	req := &evm.QueryEthAccountRequest{Address: to}
+	isBech32, e := req.Validate()
+	if e != nil {
+		err = fmt.Errorf("\"to\" is not a valid address (%s): %w", to, e)
+		return
+	}
+	if isBech32 {
+		toAddr = sdk.MustAccAddressFromBech32(req.Address)
+	} else {
+		toAddr = eth.EthAddrToNibiruAddr(gethcommon.HexToAddress(req.Address))
+	}
+
+	argIdx++
+	denom, err := parseArgBankDenom(args[argIdx])
+	if err != nil {
+		return
+	}
+
+	argIdx++
+	amount, ok := args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[argIdx])
+		return
+	}
+	if amount == nil || amount.Sign() != 1 {
+		err = fmt.Errorf("transfer amount must be positive")
+		return
+	}
+
+	return toAddr, sdk.NewCoin(denom, math.NewIntFromBigInt(amount)), nil
+}
+
+// balanceOf: Implements "IBank.balanceOf"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function balanceOf(
+//	    address who,
+//	    string memory denom
+//	) external view returns (uint256 amount);
+//	```
+func (p precompileBank) balanceOf(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+	who, err := parseArgBankWho(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+	denom, err := parseArgBankDenom(args[1])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	bal := p.evmKeeper.Bank.GetBalance(ctx, who, denom)
+	return method.Outputs.Pack(bal.Amount.BigInt())
+}
+
+// allBalances: Implements "IBank.allBalances"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function allBalances(
+//	    address who
+//	) external view returns (BankCoin[] memory balances);
+//	```
+func (p precompileBank) allBalances(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	who, err := parseArgBankWho(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	balances := []struct {
+		Denom  string   `json:"denom"`
+		Amount *big.Int `json:"amount"`
+	}{}
+	for _, coin := range p.evmKeeper.Bank.GetAllBalances(ctx, who) {
+		balances = append(balances, struct {
+			Denom  string   `json:"denom"`
+			Amount *big.Int `json:"amount"`
+		}{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		})
+	}
+	return method.Outputs.Pack(balances)
+}
+
+// totalSupply: Implements "IBank.totalSupply"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function totalSupply(
+//	    string memory denom
+//	) external view returns (uint256 amount);
+//	```
+func (p precompileBank) totalSupply(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	denom, err := parseArgBankDenom(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	supply := p.evmKeeper.Bank.GetSupply(ctx, denom)
+	return method.Outputs.Pack(supply.Amount.BigInt())
+}
+
+// denomMetadata: Implements "IBank.denomMetadata"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function denomMetadata(
+//	    string memory denom
+//	) external view returns (DenomMetadata memory metadata);
+//	```
+func (p precompileBank) denomMetadata(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	denom, err := parseArgBankDenom(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	md, found := p.evmKeeper.Bank.GetDenomMetaData(ctx, denom)
+	if !found {
+		return nil, fmt.Errorf("no metadata found for denom \"%s\"", denom)
+	}
+
+	type denomUnit struct {
+		Denom    string   `json:"denom"`
+		Exponent uint32   `json:"exponent"`
+		Aliases  []string `json:"aliases"`
+	}
+	denomUnits := []denomUnit{}
+	for _, unit := range md.DenomUnits {
+		aliases := unit.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+		denomUnits = append(denomUnits, denomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  aliases,
+		})
+	}
+
+	return method.Outputs.Pack(struct {
+		Description string      `json:"description"`
+		DenomUnits  []denomUnit `json:"denomUnits"`
+		Base        string      `json:"base"`
+		Display     string      `json:"display"`
+		Name        string      `json:"name"`
+		Symbol      string      `json:"symbol"`
+	}{
+		Description: md.Description,
+		DenomUnits:  denomUnits,
+		Base:        md.Base,
+		Display:     md.Display,
+		Name:        md.Name,
+		Symbol:      md.Symbol,
+	})
+}
+
+// parseArgBankWho parses the Nibiru address of an account from an "address"
+// ABI argument.
+func parseArgBankWho(arg any) (who sdk.AccAddress, err error) {
+	addrEth, ok := arg.(gethcommon.Address)
+	if !ok {
+		return nil, ErrArgTypeValidation("address who", arg)
+	}
+	return eth.EthAddrToNibiruAddr(addrEth), nil
+}
+
+// parseArgBankDenom parses a valid bank denomination from a "string" ABI
+// argument.
+func parseArgBankDenom(arg any) (denom string, err error) {
+	denom, ok := arg.(string)
+	if !ok {
+		return "", ErrArgTypeValidation("string denom", arg)
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", err
+	}
+	return denom, nil
+}
@@ -0,0 +1,317 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile/test"
+)
+
+type BankSuite struct {
+	suite.Suite
+}
+
+// TestBankSuite: Runs all the tests in the suite.
+func TestBankSuite(t *testing.T) {
+	suite.Run(t, new(BankSuite))
+}
+
+func (s *BankSuite) TestFailToPackABI() {
+	testcases := []struct {
+		name       string
+		methodName string
+		callArgs   []any
+		wantError  string
+	}{
+		{
+			name:       "wrong amount of call args",
+			methodName: string(precompile.BankMethod_send),
+			callArgs:   []any{"nibi1", "ibc/foo"},
+			wantError:  "argument count mismatch: got 2 for 3",
+		},
+		{
+			name:       "wrong type for amount",
+			methodName: string(precompile.BankMethod_send),
+			callArgs:   []any{"nibi1", "ibc/foo", "1"},
+			wantError:  "abi: cannot use string as type ptr as argument",
+		},
+		{
+			name:       "wrong type for who",
+			methodName: string(precompile.BankMethod_balanceOf),
+			callArgs:   []any{"nibi1", "ibc/foo"},
+			wantError:  "abi: cannot use string as type array as argument",
+		},
+		{
+			name:       "invalid method name",
+			methodName: "foo",
+			callArgs:   []any{"ibc/foo"},
+			wantError:  "method 'foo' not found",
+		},
+	}
+
+	abi := embeds.SmartContract_Bank.ABI
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			input, err := abi.Pack(tc.methodName, tc.callArgs...)
+			s.ErrorContains(err, tc.wantError)
+			s.Nil(input)
+		})
+	}
+}
+
+func (s *BankSuite) TestHappyPath() {
+	deps := evmtest.NewTestDeps()
+	bankDenom := "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0"
+	recipient := evmtest.NewEthPrivAcc()
+
+	s.T().Log("Fund sender with an IBC coin and NIBI")
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(
+			sdk.NewCoin(bankDenom, sdk.NewInt(69_420)),
+			sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(420)),
+		),
+	))
+	// Leftover gas fee is refunded within EthereumTx from the FeeCollector
+	// so, the module must have some coins
+	s.Require().NoError(testapp.FundModuleAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(1_000_000))),
+	))
+	deps.App.BankKeeper.SetDenomMetaData(deps.Ctx, banktypes.Metadata{
+		Description: "IBC voucher",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: bankDenom, Exponent: 0},
+			{Denom: "ATOM", Exponent: 6, Aliases: []string{"atom"}},
+		},
+		Base:    bankDenom,
+		Display: "ATOM",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+	})
+
+	queryBank := func(method precompile.PrecompileMethod, args ...any) []byte {
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx,
+			embeds.SmartContract_Bank.ABI,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Bank,
+			false,
+			keeper.Erc20GasLimitExecute,
+			string(method),
+			args...,
+		)
+		s.Require().NoError(err, evmResp)
+		return evmResp.Ret
+	}
+
+	s.Run("IBank.balanceOf", func() {
+		var amount *big.Int
+		s.Require().NoError(embeds.SmartContract_Bank.ABI.UnpackIntoInterface(
+			&amount, string(precompile.BankMethod_balanceOf),
+			queryBank(precompile.BankMethod_balanceOf, deps.Sender.EthAddr, bankDenom),
+		))
+		s.Equal("69420", amount.String())
+	})
+
+	s.Run("IBank.allBalances", func() {
+		out := new(BankAllBalancesReturn)
+		s.Require().NoError(embeds.SmartContract_Bank.ABI.UnpackIntoInterface(
+			out, string(precompile.BankMethod_allBalances),
+			queryBank(precompile.BankMethod_allBalances, deps.Sender.EthAddr),
+		))
+		s.Require().Len(out.Balances, 2)
+		s.Equal(bankDenom, out.Balances[0].Denom)
+		s.Equal("69420", out.Balances[0].Amount.String())
+		s.Equal(evm.EVMBankDenom, out.Balances[1].Denom)
+		s.Equal("420", out.Balances[1].Amount.String())
+	})
+
+	s.Run("IBank.totalSupply", func() {
+		var amount *big.Int
+		s.Require().NoError(embeds.SmartContract_Bank.ABI.UnpackIntoInterface(
+			&amount, string(precompile.BankMethod_totalSupply),
+			queryBank(precompile.BankMethod_totalSupply, bankDenom),
+		))
+		s.Equal("69420", amount.String())
+	})
+
+	s.Run("IBank.denomMetadata", func() {
+		out := new(BankDenomMetadataReturn)
+		s.Require().NoError(embeds.SmartContract_Bank.ABI.UnpackIntoInterface(
+			out, string(precompile.BankMethod_denomMetadata),
+			queryBank(precompile.BankMethod_denomMetadata, bankDenom),
+		))
+		s.Equal("IBC voucher", out.Metadata.Description)
+		s.Equal(bankDenom, out.Metadata.Base)
+		s.Equal("ATOM", out.Metadata.Display)
+		s.Equal("Cosmos Hub Atom", out.Metadata.Name)
+		s.Equal("ATOM", out.Metadata.Symbol)
+		s.Require().Len(out.Metadata.DenomUnits, 2)
+		s.Equal("ATOM", out.Metadata.DenomUnits[1].Denom)
+		s.Equal(uint32(6), out.Metadata.DenomUnits[1].Exponent)
+		s.Equal([]string{"atom"}, out.Metadata.DenomUnits[1].Aliases)
+	})
+
+	s.T().Log("Send the IBC coin to a Bech32 address using the precompile")
+	input, err := embeds.SmartContract_Bank.ABI.Pack(
+		string(precompile.BankMethod_send),
+		recipient.NibiruAddr.String(),
+		bankDenom,
+		big.NewInt(69_000),
+	)
+	s.Require().NoError(err)
+	deps.ResetGasMeter()
+	_, ethTxResp, err := evmtest.CallContractTx(
+		&deps, precompile.PrecompileAddr_Bank, input, deps.Sender,
+	)
+	s.Require().NoError(err)
+	s.Require().Empty(ethTxResp.VmError)
+	s.Equal("420", deps.App.BankKeeper.GetBalance(
+		deps.Ctx, deps.Sender.NibiruAddr, bankDenom).Amount.String(),
+	)
+	s.Equal("69000", deps.App.BankKeeper.GetBalance(
+		deps.Ctx, recipient.NibiruAddr, bankDenom).Amount.String(),
+	)
+	eventLog, eventData := test.RequirePrecompileEventLog(
+		&s.Suite, ethTxResp.Logs, precompile.PrecompileAddr_Bank,
+		embeds.SmartContract_Bank.ABI, "Send",
+	)
+	s.Equal(gethcommon.BytesToHash(deps.Sender.EthAddr.Bytes()), eventLog.Topics[1])
+	s.Equal([]any{recipient.NibiruAddr.String(), bankDenom, big.NewInt(69_000)}, eventData)
+
+	s.T().Log("Send NIBI to a hex address using the precompile")
+	input, err = embeds.SmartContract_Bank.ABI.Pack(
+		string(precompile.BankMethod_send),
+		recipient.EthAddr.Hex(),
+		evm.EVMBankDenom,
+		big.NewInt(20),
+	)
+	s.Require().NoError(err)
+	deps.ResetGasMeter()
+	_, ethTxResp, err = evmtest.CallContractTx(
+		&deps, precompile.PrecompileAddr_Bank, input, deps.Sender,
+	)
+	s.Require().NoError(err)
+	s.Require().Empty(ethTxResp.VmError)
+	s.Equal("20", deps.App.BankKeeper.GetBalance(
+		deps.Ctx, recipient.NibiruAddr, evm.EVMBankDenom).Amount.String(),
+	)
+	s.Equal(
+		"20",
+		deps.EvmKeeper.GetEvmGasBalance(deps.Ctx, recipient.EthAddr).String(),
+		"the EVM balance of the recipient must reflect the bank send",
+	)
+}
+
+func (s *BankSuite) TestSadPaths() {
+	deps := evmtest.NewTestDeps()
+	recipient := evmtest.NewEthPrivAcc()
+
+	for _, tc := range []struct {
+		name      string
+		args      []any
+		wantError string
+	}{
+		{
+			name:      "invalid recipient",
+			args:      []any{"not_an_address", "ibc/foo", big.NewInt(1)},
+			wantError: "\"to\" is not a valid address",
+		},
+		{
+			name:      "invalid denom",
+			args:      []any{recipient.EthAddr.Hex(), "?", big.NewInt(1)},
+			wantError: "invalid denom",
+		},
+		{
+			name:      "amount must be positive",
+			args:      []any{recipient.EthAddr.Hex(), "ibc/foo", big.NewInt(0)},
+			wantError: "transfer amount must be positive",
+		},
+		{
+			name:      "insufficient funds",
+			args:      []any{recipient.EthAddr.Hex(), "ibc/foo", big.NewInt(1)},
+			wantError: "insufficient funds",
+		},
+		{
+			name: "blocked module address",
+			args: []any{
+				authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+				evm.EVMBankDenom,
+				big.NewInt(1),
+			},
+			wantError: "is not allowed to receive funds",
+		},
+	} {
+		s.Run(tc.name, func() {
+			input, err := embeds.SmartContract_Bank.ABI.Pack(
+				string(precompile.BankMethod_send), tc.args...,
+			)
+			s.Require().NoError(err)
+			deps.ResetGasMeter()
+			_, _, err = evmtest.CallContractTx(
+				&deps, precompile.PrecompileAddr_Bank, input, deps.Sender,
+			)
+			s.Require().ErrorContains(err, tc.wantError)
+		})
+	}
+
+	s.T().Log("Querying metadata of a denom without metadata fails")
+	_, err := deps.EvmKeeper.CallContract(
+		deps.Ctx,
+		embeds.SmartContract_Bank.ABI,
+		deps.Sender.EthAddr,
+		&precompile.PrecompileAddr_Bank,
+		false,
+		keeper.Erc20GasLimitExecute,
+		string(precompile.BankMethod_denomMetadata),
+		"ibc/foo",
+	)
+	s.Require().ErrorContains(err, "no metadata found for denom")
+
+}
+
+// BankAllBalancesReturn holds the return values from the "IBank.allBalances"
+// method. The return bytes from successful calls of that method can be ABI
+// unpacked into this struct.
+type BankAllBalancesReturn struct {
+	Balances []struct {
+		Denom  string   `abi:"denom"`
+		Amount *big.Int `abi:"amount"`
+	} `abi:"balances"`
+}
+
+// BankDenomMetadataReturn holds the return values from the
+// "IBank.denomMetadata" method. The return bytes from successful calls of that
+// method can be ABI unpacked into this struct.
+type BankDenomMetadataReturn struct {
+	Metadata struct {
+		Description string `abi:"description"`
+		DenomUnits  []struct {
+			Denom    string   `abi:"denom"`
+			Exponent uint32   `abi:"exponent"`
+			Aliases  []string `abi:"aliases"`
+		} `abi:"denomUnits"`
+		Base    string `abi:"base"`
+		Display string `abi:"display"`
+		Name    string `abi:"name"`
+		Symbol  string `abi:"symbol"`
+	} `abi:"metadata"`
+}
@@ -39,6 +39,7 @@ func (p precompileFunToken) ABI() *gethabi.ABI {
 
 const (
 	FunTokenMethod_sendToBank  PrecompileMethod = "sendToBank"
+	FunTokenMethod_sendToEvm   PrecompileMethod = "sendToEvm"
 	FunTokenMethod_balance     PrecompileMethod = "balance"
 	FunTokenMethod_bankBalance PrecompileMethod = "bankBalance"
 	FunTokenMethod_whoAmI      PrecompileMethod = "whoAmI"
@@ -48,41 +49,13 @@ const (
 func (p precompileFunToken) Run(
 	evm *vm.EVM, contract *vm.Contract, readonly bool,
 ) (bz []byte, err error) {
-	defer func() {
-		err = ErrPrecompileRun(err, p)
-	}()
-	startResult, err := OnRunStart(evm, contract.Input, p.ABI(), contract.Gas)
-	if err != nil {
-		return nil, err
-	}
-	p.evmKeeper.Bank.StateDB = startResult.StateDB
-
-	// Gracefully handles "out of gas"
-	defer HandleOutOfGasPanic(&err)()
-
-	method := startResult.Method
-	switch PrecompileMethod(method.Name) {
-	case FunTokenMethod_sendToBank:
-		bz, err = p.sendToBank(startResult, contract.CallerAddress, readonly)
-	case FunTokenMethod_balance:
-		bz, err = p.balance(startResult, contract)
-	case FunTokenMethod_bankBalance:
-		bz, err = p.bankBalance(startResult, contract)
-	case FunTokenMethod_whoAmI:
-		bz, err = p.whoAmI(startResult, contract)
-	default:
-		// Note that this code path should be impossible to reach since
-		// "[decomposeInput]" parses methods directly from the ABI.
-		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
-		return
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	// Gas consumed by a local gas meter
-	contract.UseGas(startResult.CacheCtx.GasMeter().GasConsumed())
-	return bz, err
+	return runPrecompile(p, evm, contract, readonly, p.evmKeeper.Bank, map[PrecompileMethod]precompileMethod{
+		FunTokenMethod_sendToBank:  p.sendToBank,
+		FunTokenMethod_sendToEvm:   p.sendToEvm,
+		FunTokenMethod_balance:     p.balance,
+		FunTokenMethod_bankBalance: p.bankBalance,
+		FunTokenMethod_whoAmI:      p.whoAmI,
+	})
 }
 
 func PrecompileFunToken(keepers keepers.PublicKeepers) vm.PrecompiledContract {
@@ -109,20 +82,14 @@ type precompileFunToken struct {
 func (p precompileFunToken) sendToBank(
 	startResult OnRunStartResult,
 	caller gethcommon.Address,
-	readOnly bool,
 ) (bz []byte, err error) {
 	ctx, method, args := startResult.CacheCtx, startResult.Method, startResult.Args
-	if err := assertNotReadonlyTx(readOnly, method); err != nil {
-		return nil, err
-	}
 
 	erc20, amount, to, err := p.parseArgsSendToBank(args)
 	if err != nil {
 		return
 	}
 
-	var evmResponses []*evm.MsgEthereumTxResponse
-
 	// ERC20 must have FunToken mapping
 	funtokens := p.evmKeeper.FunTokens.Collect(
 		ctx, p.evmKeeper.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20),
@@ -144,9 +111,47 @@ func (p precompileFunToken) sendToBank(
 		return nil, fmt.Errorf("\"to\" is not a valid address (%s): %w", to, err)
 	}
 
+	gotAmount, err := sendERC20ToBank(startResult, p.Address(), p.evmKeeper, funtoken, caller, amount, toAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = emitEventLog(
+		startResult, p.Address(), p.ABI(), "SendToBank", caller, erc20, to, gotAmount,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(gotAmount)
+}
+
+// sendERC20ToBank converts "amount" of the ERC20 tokens of a FunToken mapping
+// held by "caller" into bank coins and sends them to "toAddr". The ERC20
+// tokens are either burned, if the mapping was created from a bank coin, or
+// escrowed in the EVM module account, in which case the bank coins are minted.
+// EVM logs produced by the ERC20 contract calls are added to the StateDB, and
+// the ERC20 and bank calls are reported to the active tracer as child frames of
+// the call to the precompile at "precompileAddr".
+//
+// Returns the amount of tokens received by the recipient, which may differ from
+// "amount" if the ERC20 contract has a fee or deduction on transfer.
+func sendERC20ToBank(
+	startResult OnRunStartResult,
+	precompileAddr gethcommon.Address,
+	evmKeeper *evmkeeper.Keeper,
+	funtoken evm.FunToken,
+	caller gethcommon.Address,
+	amount *big.Int,
+	toAddr sdk.AccAddress,
+) (gotAmount *big.Int, err error) {
+	ctx := startResult.CacheCtx
+	erc20 := funtoken.Erc20Addr.Address
+	var evmResponses []*evm.MsgEthereumTxResponse
+
 	// Caller transfers ERC20 to the EVM account
 	transferTo := evm.EVM_MODULE_ADDRESS
-	gotAmount, transferResp, err := p.evmKeeper.ERC20().Transfer(erc20, caller, transferTo, amount, ctx)
+	gotAmount, transferResp, err := evmKeeper.ERC20().Transfer(erc20, caller, transferTo, amount, ctx)
+	traceERC20Call(startResult, caller, erc20, transferResp, err, "transfer", transferTo, amount)
 	if err != nil {
 		return nil, fmt.Errorf("error in ERC20.transfer from caller to EVM account: %w", err)
 	}
@@ -159,7 +164,8 @@ func (p precompileFunToken) sendToBank(
 		// owns the ERC20 contract and was the original minter of the ERC20 tokens.
 		// Since we're sending them away and want accurate total supply tracking, the
 		// tokens need to be burned.
-		burnResp, e := p.evmKeeper.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx)
+		burnResp, e := evmKeeper.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx)
+		traceERC20Call(startResult, evm.EVM_MODULE_ADDRESS, erc20, burnResp, e, "burn", gotAmount)
 		if e != nil {
 			err = fmt.Errorf("ERC20.Burn: %w", e)
 			return
@@ -170,8 +176,14 @@ func (p precompileFunToken) sendToBank(
 		// any operation that has the potential to use Bank send methods. This will
 		// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
 		// recorded if wei (NIBI) is transferred.
-		p.evmKeeper.Bank.StateDB = startResult.StateDB
-		err = p.evmKeeper.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(coinToSend))
+		evmKeeper.Bank.StateDB = startResult.StateDB
+		err = traceBankCall(
+			startResult, precompileAddr, "bank.MintCoins",
+			bankModuleCall{Module: evm.ModuleName, Amount: sdk.NewCoins(coinToSend)},
+			func() error {
+				return evmKeeper.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(coinToSend))
+			},
+		)
 		if err != nil {
 			return nil, fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
 				evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), caller.Hex(), err,
@@ -185,12 +197,18 @@ func (p precompileFunToken) sendToBank(
 	// any operation that has the potential to use Bank send methods. This will
 	// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
 	// recorded if wei (NIBI) is transferred.
-	p.evmKeeper.Bank.StateDB = startResult.StateDB
-	err = p.evmKeeper.Bank.SendCoinsFromModuleToAccount(
-		ctx,
-		evm.ModuleName,
-		toAddr,
-		sdk.NewCoins(coinToSend),
+	evmKeeper.Bank.StateDB = startResult.StateDB
+	err = traceBankCall(
+		startResult, precompileAddr, "bank.SendCoinsFromModuleToAccount",
+		bankModuleCall{Module: evm.ModuleName, Account: toAddr.String(), Amount: sdk.NewCoins(coinToSend)},
+		func() error {
+			return evmKeeper.Bank.SendCoinsFromModuleToAccount(
+				ctx,
+				evm.ModuleName,
+				toAddr,
+				sdk.NewCoins(coinToSend),
+			)
+		},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("send failed for module \"%s\" (%s): contract caller %s: %w",
@@ -203,11 +221,7 @@ func (p precompileFunToken) sendToBank(
 		}
 	}
 
-	// TODO: UD-DEBUG: feat: Emit EVM events
-	// TODO: emit event for balance change of sender
-	// TODO: emit event for balance change of recipient
-
-	return method.Outputs.Pack(gotAmount)
+	return gotAmount, nil
 }
 
 func (p precompileFunToken) parseArgsSendToBank(args []any) (
@@ -245,6 +259,196 @@ func (p precompileFunToken) parseArgsSendToBank(args []any) (
 	return
 }
 
+// sendToEvm: Implements "IFunToken.sendToEvm"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function sendToEvm(
+//	    string calldata bankDenom,
+//	    uint256 amount,
+//	    string calldata to
+//	) external returns (uint256 sentAmount);
+//	```
+func (p precompileFunToken) sendToEvm(
+	startResult OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	ctx, method, args := startResult.CacheCtx, startResult.Method, startResult.Args
+
+	bankDenom, amount, to, err := p.parseArgsSendToEvm(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	// Bank denom must have FunToken mapping
+	funtokens := p.evmKeeper.FunTokens.Collect(
+		ctx, p.evmKeeper.FunTokens.Indexes.BankDenom.ExactMatch(ctx, bankDenom),
+	)
+	if len(funtokens) != 1 {
+		err = fmt.Errorf("no FunToken mapping exists for bank denom \"%s\"", bankDenom)
+		return
+	}
+	funtoken := funtokens[0]
+
+	gotAmount, err := sendBankToERC20(startResult, p.Address(), p.evmKeeper, funtoken, caller, amount, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = emitEventLog(
+		startResult, p.Address(), p.ABI(), "SendToEvm", caller, bankDenom, to, gotAmount,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(gotAmount)
+}
+
+// sendBankToERC20 converts "amount" of the bank coins of a FunToken mapping
+// held by "caller" into ERC20 tokens and sends them to "to". If the mapping
+// was created from a bank coin, the coins are escrowed in the EVM module
+// account and the ERC20 tokens are minted. Otherwise, the escrowed ERC20
+// tokens are transferred and the coins are burned. EVM logs produced by the
+// ERC20 contract calls are added to the StateDB, and the ERC20 and bank calls
+// are reported to the active tracer as child frames of the call to the
+// precompile at "precompileAddr".
+//
+// Returns the amount of tokens received by the recipient, which may differ from
+// "amount" if the ERC20 contract has a fee or deduction on transfer.
+func sendBankToERC20(
+	startResult OnRunStartResult,
+	precompileAddr gethcommon.Address,
+	evmKeeper *evmkeeper.Keeper,
+	funtoken evm.FunToken,
+	caller gethcommon.Address,
+	amount *big.Int,
+	to gethcommon.Address,
+) (gotAmount *big.Int, err error) {
+	ctx := startResult.CacheCtx
+	erc20 := funtoken.Erc20Addr.Address
+
+	// Caller transfers the bank coins to the EVM module account
+	//
+	// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
+	// any operation that has the potential to use Bank send methods. This will
+	// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
+	// recorded if wei (NIBI) is transferred.
+	evmKeeper.Bank.StateDB = startResult.StateDB
+	coin := sdk.NewCoin(funtoken.BankDenom, math.NewIntFromBigInt(amount))
+	callerBech32 := eth.EthAddrToNibiruAddr(caller)
+	err = traceBankCall(
+		startResult, precompileAddr, "bank.SendCoinsFromAccountToModule",
+		bankModuleCall{Module: evm.ModuleName, Account: callerBech32.String(), Amount: sdk.NewCoins(coin)},
+		func() error {
+			return evmKeeper.Bank.SendCoinsFromAccountToModule(
+				ctx,
+				callerBech32,
+				evm.ModuleName,
+				sdk.NewCoins(coin),
+			)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("send failed from contract caller %s to module \"%s\": %w",
+			caller.Hex(), evm.ModuleName, err,
+		)
+	}
+
+	var evmResp *evm.MsgEthereumTxResponse
+	if funtoken.IsMadeFromCoin {
+		// If the FunToken mapping was created from a bank coin, then the EVM
+		// account owns the ERC20 contract and mints the ERC20 tokens. The bank
+		// coins stay escrowed in the EVM module.
+		evmResp, err = evmKeeper.ERC20().Mint(erc20, evm.EVM_MODULE_ADDRESS, to, amount, ctx)
+		traceERC20Call(startResult, evm.EVM_MODULE_ADDRESS, erc20, evmResp, err, "mint", to, amount)
+		if err != nil {
+			return nil, fmt.Errorf("ERC20.Mint: %w", err)
+		}
+		gotAmount = amount
+	} else {
+		// Otherwise, the EVM account holds the ERC20 tokens in escrow from the
+		// preceding ERC20 → bank conversion, and the bank coins are burned to
+		// preserve an invariant on the sum of the bank and ERC20 supply.
+		gotAmount, evmResp, err = evmKeeper.ERC20().Transfer(erc20, evm.EVM_MODULE_ADDRESS, to, amount, ctx)
+		traceERC20Call(startResult, evm.EVM_MODULE_ADDRESS, erc20, evmResp, err, "transfer", to, amount)
+		if err != nil {
+			return nil, fmt.Errorf("error in ERC20.transfer from EVM account to recipient: %w", err)
+		}
+		evmKeeper.Bank.StateDB = startResult.StateDB
+		burnCoin := sdk.NewCoin(funtoken.BankDenom, math.NewIntFromBigInt(gotAmount))
+		err = traceBankCall(
+			startResult, precompileAddr, "bank.BurnCoins",
+			bankModuleCall{Module: evm.ModuleName, Amount: sdk.NewCoins(burnCoin)},
+			func() error {
+				return evmKeeper.Bank.BurnCoins(ctx, evm.ModuleName, sdk.NewCoins(burnCoin))
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("burn failed for module \"%s\" (%s): contract caller %s: %w",
+				evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), caller.Hex(), err,
+			)
+		}
+	}
+
+	for _, log := range evmResp.Logs {
+		startResult.StateDB.AddLog(log.ToEthereum())
+	}
+
+	return gotAmount, nil
+}
+
+func (p precompileFunToken) parseArgsSendToEvm(args []any) (
+	bankDenom string,
+	amount *big.Int,
+	to gethcommon.Address,
+	err error,
+) {
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	bankDenom, ok := args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string bankDenom", args[argIdx])
+		return
+	}
+
+	argIdx++
+	amount, ok = args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[argIdx])
+		return
+	}
+	if amount == nil || amount.Sign() != 1 {
+		err = fmt.Errorf("transfer amount must be positive")
+		return
+	}
+
+	argIdx++
+	toStr, ok := args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string to", args[argIdx])
+		return
+	}
+	req := &evm.QueryEthAccountRequest{Address: toStr}
+	isBech32, e := req.Validate()
+	if e != nil {
+		err = fmt.Errorf("\"to\" is not a valid address (%s): %w", toStr, e)
+		return
+	}
+	if isBech32 {
+		to = eth.NibiruAddrToEthAddr(sdk.MustAccAddressFromBech32(req.Address))
+	} else {
+		to = gethcommon.HexToAddress(req.Address)
+	}
+
+	return bankDenom, amount, to, nil
+}
+
 // balance: Implements "IFunToken.balance"
 //
 // The "args" populate the following function signature in Solidity:
@@ -264,17 +468,9 @@ func (p precompileFunToken) parseArgsSendToBank(args []any) (
 //	```
 func (p precompileFunToken) balance(
 	start OnRunStartResult,
-	contract *vm.Contract,
+	_ gethcommon.Address,
 ) (bz []byte, err error) {
 	method, args, ctx := start.Method, start.Args, start.CacheCtx
-	defer func() {
-		if err != nil {
-			err = ErrMethodCalled(method, err)
-		}
-	}()
-	if err := assertContractQuery(contract); err != nil {
-		return bz, err
-	}
 
 	addrEth, addrBech32, funtoken, err := p.parseArgsBalance(args, ctx)
 	if err != nil {
@@ -363,17 +559,9 @@ func (p precompileFunToken) parseArgsBalance(args []any, ctx sdk.Context) (
 //	```
 func (p precompileFunToken) bankBalance(
 	start OnRunStartResult,
-	contract *vm.Contract,
+	_ gethcommon.Address,
 ) (bz []byte, err error) {
 	method, args, ctx := start.Method, start.Args, start.CacheCtx
-	defer func() {
-		if err != nil {
-			err = ErrMethodCalled(method, err)
-		}
-	}()
-	if err := assertContractQuery(contract); err != nil {
-		return bz, err
-	}
 
 	addrEth, addrBech32, bankDenom, err := p.parseArgsBankBalance(args)
 	bankBal := p.evmKeeper.Bank.GetBalance(ctx, addrBech32, bankDenom).Amount.BigInt()
@@ -441,17 +629,9 @@ func (p precompileFunToken) parseArgsBankBalance(args []any) (
 //	```
 func (p precompileFunToken) whoAmI(
 	start OnRunStartResult,
-	contract *vm.Contract,
+	_ gethcommon.Address,
 ) (bz []byte, err error) {
 	method, args := start.Method, start.Args
-	defer func() {
-		if err != nil {
-			err = ErrMethodCalled(method, err)
-		}
-	}()
-	if err := assertContractQuery(contract); err != nil {
-		return bz, err
-	}
 
 	addrEth, addrBech32, err := p.parseArgsWhoAmI(args)
 	if err != nil {
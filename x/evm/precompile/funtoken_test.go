@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
 	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile/test"
 )
 
 // TestSuite: Runs all the tests in the suite.
@@ -255,6 +257,17 @@ func (s *FuntokenSuite) TestHappyPath() {
 	s.NoError(err)
 	s.Require().Equal("420", sentAmt.String())
 
+	s.T().Log("The precompile emits a SendToBank event log")
+	eventLog, eventData := test.RequirePrecompileEventLog(
+		&s.Suite, ethTxResp.Logs, precompile.PrecompileAddr_FunToken,
+		embeds.SmartContract_FunToken.ABI, "SendToBank",
+	)
+	s.Require().Len(eventLog.Topics, 3)
+	s.Equal(gethcommon.BytesToHash(deps.Sender.EthAddr.Bytes()), eventLog.Topics[1])
+	s.Equal(gethcommon.BytesToHash(erc20.Bytes()), eventLog.Topics[2])
+	s.Equal([]any{randomAcc.String(), big.NewInt(420)}, eventData)
+	s.EqualValues(deps.Ctx.BlockHeight(), eventLog.BlockNumber)
+
 	s.Run("IFuntoken.balance", func() {
 		evmResp, err := deps.EvmKeeper.CallContract(
 			deps.Ctx,
@@ -281,6 +294,130 @@ func (s *FuntokenSuite) TestHappyPath() {
 	})
 }
 
+func (s *FuntokenSuite) TestSendToEvm() {
+	deps := evmtest.NewTestDeps()
+	alice := evmtest.NewEthPrivAcc()
+	// Leftover gas fee is refunded within EthereumTx from the FeeCollector
+	// so, the module must have some coins
+	s.Require().NoError(testapp.FundModuleAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(1_000_000))),
+	))
+
+	sendToEvm := func(bankDenom string, amount int64, to string) (*big.Int, error) {
+		input, err := embeds.SmartContract_FunToken.ABI.Pack(
+			string(precompile.FunTokenMethod_sendToEvm), bankDenom, big.NewInt(amount), to,
+		)
+		s.Require().NoError(err)
+		deps.ResetGasMeter()
+		_, ethTxResp, err := evmtest.CallContractTx(
+			&deps, precompile.PrecompileAddr_FunToken, input, deps.Sender,
+		)
+		if err != nil {
+			return nil, err
+		}
+		s.Require().Empty(ethTxResp.VmError)
+		var sentAmt *big.Int
+		s.Require().NoError(embeds.SmartContract_FunToken.ABI.UnpackIntoInterface(
+			&sentAmt, string(precompile.FunTokenMethod_sendToEvm), ethTxResp.Ret,
+		))
+		return sentAmt, nil
+	}
+
+	s.Run("FunToken created from a bank coin", func() {
+		funtoken := evmtest.CreateFunTokenForBankCoin(&deps, "ufoo", &s.Suite)
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper,
+			deps.Ctx,
+			deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewCoin(funtoken.BankDenom, sdk.NewInt(69_420))),
+		))
+
+		sentAmt, err := sendToEvm(funtoken.BankDenom, 420, alice.EthAddr.Hex())
+		s.Require().NoError(err)
+		s.Equal("420", sentAmt.String())
+
+		evmtest.AssertERC20BalanceEqual(
+			s.T(), deps, funtoken.Erc20Addr.Address, alice.EthAddr, big.NewInt(420),
+		)
+		s.Equal("69000", deps.App.BankKeeper.GetBalance(
+			deps.Ctx, deps.Sender.NibiruAddr, funtoken.BankDenom).Amount.String(),
+		)
+		s.Equal("420", deps.App.BankKeeper.GetBalance(
+			deps.Ctx, authtypes.NewModuleAddress(evm.ModuleName), funtoken.BankDenom).Amount.String(),
+			"bank coins should be escrowed in the EVM module",
+		)
+
+		s.T().Log("sad: insufficient funds")
+		_, err = sendToEvm(funtoken.BankDenom, 70_000, alice.NibiruAddr.String())
+		s.Require().ErrorContains(err, "insufficient funds")
+	})
+
+	s.Run("FunToken created from an ERC20", func() {
+		deployResp, err := evmtest.DeployContract(
+			&deps, embeds.SmartContract_ERC20Minter, "erc20name", "TOKEN", uint8(18),
+		)
+		s.Require().NoError(err)
+		erc20 := deployResp.ContractAddr
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper,
+			deps.Ctx,
+			deps.Sender.NibiruAddr,
+			deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+		))
+		resp, err := deps.EvmKeeper.CreateFunToken(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgCreateFunToken{
+				FromErc20: &eth.EIP55Addr{Address: erc20},
+				Sender:    deps.Sender.NibiruAddr.String(),
+			},
+		)
+		s.Require().NoError(err)
+		bankDenom := resp.FuntokenMapping.BankDenom
+
+		s.T().Log("Mint ERC20 tokens and convert them to bank coins")
+		_, err = deps.EvmKeeper.CallContract(
+			deps.Ctx, embeds.SmartContract_ERC20Minter.ABI, deps.Sender.EthAddr,
+			&erc20, true, keeper.Erc20GasLimitExecute,
+			"mint", deps.Sender.EthAddr, big.NewInt(1_000),
+		)
+		s.Require().NoError(err)
+		_, err = deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:     deps.Sender.NibiruAddr.String(),
+				Erc20Addr:  eth.EIP55Addr{Address: erc20},
+				Amount:     sdk.NewInt(1_000),
+				ToBankAddr: deps.Sender.NibiruAddr.String(),
+			},
+		)
+		s.Require().NoError(err)
+
+		s.T().Log("Send the bank coins back to the EVM using a Bech32 recipient")
+		sentAmt, err := sendToEvm(bankDenom, 600, alice.NibiruAddr.String())
+		s.Require().NoError(err)
+		s.Equal("600", sentAmt.String())
+
+		evmtest.AssertERC20BalanceEqual(s.T(), deps, erc20, alice.EthAddr, big.NewInt(600))
+		evmtest.AssertERC20BalanceEqual(s.T(), deps, erc20, evm.EVM_MODULE_ADDRESS, big.NewInt(400))
+		s.Equal("400", deps.App.BankKeeper.GetSupply(deps.Ctx, bankDenom).Amount.String(),
+			"bank coins should be burned",
+		)
+	})
+
+	s.Run("sad: no FunToken mapping", func() {
+		_, err := sendToEvm("ibc/foo", 1, alice.EthAddr.Hex())
+		s.Require().ErrorContains(err, "no FunToken mapping exists for bank denom")
+	})
+
+	s.Run("sad: invalid recipient", func() {
+		_, err := sendToEvm("ufoo", 1, "not_an_address")
+		s.Require().ErrorContains(err, "\"to\" is not a valid address")
+	})
+}
+
 // FunTokenBalanceReturn holds the return values from the "IFuntoken.balance"
 // method. The return bytes from successful calls of that method can be ABI
 // unpacked into this struct.
@@ -0,0 +1,579 @@
+package precompile
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+var _ vm.PrecompiledContract = (*precompileGov)(nil)
+
+// Precompile address for "IGovernance.sol", the contract that enables EVM
+// accounts to submit proposals, deposit, and vote in "x/gov".
+var PrecompileAddr_Governance = gethcommon.HexToAddress("0x0000000000000000000000000000000000000807")
+
+func (p precompileGov) Address() gethcommon.Address {
+	return PrecompileAddr_Governance
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileGov) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileGov) ABI() *gethabi.ABI {
+	return embeds.SmartContract_Governance.ABI
+}
+
+const (
+	GovMethod_submitProposal PrecompileMethod = "submitProposal"
+	GovMethod_deposit        PrecompileMethod = "deposit"
+	GovMethod_vote           PrecompileMethod = "vote"
+	GovMethod_voteWeighted   PrecompileMethod = "voteWeighted"
+	GovMethod_proposal       PrecompileMethod = "proposal"
+	GovMethod_tally          PrecompileMethod = "tally"
+)
+
+// Run runs the precompiled contract
+func (p precompileGov) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	return runPrecompile(p, evm, contract, readonly, p.evmKeeper.Bank, map[PrecompileMethod]precompileMethod{
+		GovMethod_submitProposal: p.submitProposal,
+		GovMethod_deposit:        p.deposit,
+		GovMethod_vote:           p.vote,
+		GovMethod_voteWeighted:   p.voteWeighted,
+		GovMethod_proposal:       p.proposal,
+		GovMethod_tally:          p.tally,
+	})
+}
+
+func PrecompileGov(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	// The account keeper holds the app codec, which is needed to decode the
+	// JSON messages of a proposal.
+	cdc, ok := keepers.AccountKeeper.GetCodec().(codec.Codec)
+	if !ok {
+		panic(fmt.Sprintf(
+			"governance precompile needs a codec.Codec to decode proposal messages, got %T",
+			keepers.AccountKeeper.GetCodec(),
+		))
+	}
+	return precompileGov{
+		evmKeeper: keepers.EvmKeeper,
+		govKeeper: keepers.GovKeeper,
+		cdc:       cdc,
+	}
+}
+
+type precompileGov struct {
+	evmKeeper *evmkeeper.Keeper
+	govKeeper govkeeper.Keeper
+	cdc       codec.Codec
+}
+
+// submitProposal: Implements "IGovernance.submitProposal"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function submitProposal(
+//	    string memory msgsJSON,
+//	    string memory metadata,
+//	    string memory title,
+//	    string memory summary,
+//	    BankCoin[] memory initialDeposit
+//	) external returns (uint64 proposalId);
+//	```
+func (p precompileGov) submitProposal(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	msg, err := p.parseArgsSubmitProposal(args, caller)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	resp, err := govkeeper.NewMsgServerImpl(&p.govKeeper).SubmitProposal(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "SubmitProposal", caller, resp.ProposalId,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(resp.ProposalId)
+}
+
+func (p precompileGov) parseArgsSubmitProposal(
+	args []any, caller gethcommon.Address,
+) (msg *govv1.MsgSubmitProposal, err error) {
+	if err := assertNumArgs(args, 5); err != nil {
+		return nil, err
+	}
+
+	argIdx := 0
+	msgsJSON, ok := args[argIdx].(string)
+	if !ok {
+		return nil, ErrArgTypeValidation("string msgsJSON", args[argIdx])
+	}
+	proposalMsgs, err := p.decodeProposalMsgs(msgsJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	argIdx++
+	metadata, ok := args[argIdx].(string)
+	if !ok {
+		return nil, ErrArgTypeValidation("string metadata", args[argIdx])
+	}
+
+	argIdx++
+	title, ok := args[argIdx].(string)
+	if !ok {
+		return nil, ErrArgTypeValidation("string title", args[argIdx])
+	}
+
+	argIdx++
+	summary, ok := args[argIdx].(string)
+	if !ok {
+		return nil, ErrArgTypeValidation("string summary", args[argIdx])
+	}
+
+	argIdx++
+	initialDeposit, err := parseArgGovCoins(args[argIdx], "BankCoin[] initialDeposit")
+	if err != nil {
+		return nil, err
+	}
+
+	return govv1.NewMsgSubmitProposal(
+		proposalMsgs,
+		initialDeposit,
+		eth.EthAddrToNibiruAddr(caller).String(),
+		metadata,
+		title,
+		summary,
+	)
+}
+
+// decodeProposalMsgs decodes a JSON array of messages in the format used by
+// the "messages" of a proposal file, where each message has an "@type" field
+// with its type URL.
+func (p precompileGov) decodeProposalMsgs(msgsJSON string) (msgs []sdk.Msg, err error) {
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal([]byte(msgsJSON), &rawMsgs); err != nil {
+		return nil, fmt.Errorf("msgsJSON must be a JSON array of messages: %w", err)
+	}
+	for idx, rawMsg := range rawMsgs {
+		var msg sdk.Msg
+		if err := p.cdc.UnmarshalInterfaceJSON(rawMsg, &msg); err != nil {
+			return nil, fmt.Errorf("failed to decode proposal message %d: %w", idx, err)
+		}
+		msgs = append(msgs, msg)
+	}
+	if err := assertGovProposalMsgsAllowed(msgs); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// assertGovProposalMsgsAllowed rejects proposal messages that would run an
+// Ethereum tx outside of a tx with the "ExtensionOptionsEthereumTx" option.
+// The checks of the ante handler don't apply to messages executed by "x/gov".
+func assertGovProposalMsgsAllowed(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if _, ok := msg.(*evm.MsgEthereumTx); ok {
+			return fmt.Errorf(
+				"proposal messages cannot contain %s", sdk.MsgTypeURL(msg),
+			)
+		}
+	}
+	return evm.AssertAuthzMsgsAllowed(msgs)
+}
+
+// deposit: Implements "IGovernance.deposit"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function deposit(
+//	    uint64 proposalId,
+//	    BankCoin[] memory amount
+//	) external returns (bool success);
+//	```
+func (p precompileGov) deposit(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+	proposalId, err := parseArgProposalId(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+	amount, err := parseArgGovCoins(args[1], "BankCoin[] amount")
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	msg := govv1.NewMsgDeposit(eth.EthAddrToNibiruAddr(caller), proposalId, amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	_, err = govkeeper.NewMsgServerImpl(&p.govKeeper).Deposit(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "Deposit", caller, proposalId, args[1],
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// vote: Implements "IGovernance.vote"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function vote(
+//	    uint64 proposalId,
+//	    uint8 option,
+//	    string memory metadata
+//	) external returns (bool success);
+//	```
+func (p precompileGov) vote(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+	proposalId, err := parseArgProposalId(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+	option, ok := args[1].(uint8)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("uint8 option", args[1]))
+		return
+	}
+	metadata, ok := args[2].(string)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("string metadata", args[2]))
+		return
+	}
+
+	msg := govv1.NewMsgVote(
+		eth.EthAddrToNibiruAddr(caller), proposalId, govv1.VoteOption(option), metadata,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	_, err = govkeeper.NewMsgServerImpl(&p.govKeeper).Vote(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "Vote", caller, proposalId, option,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// voteWeighted: Implements "IGovernance.voteWeighted"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function voteWeighted(
+//	    uint64 proposalId,
+//	    WeightedVoteOption[] memory options,
+//	    string memory metadata
+//	) external returns (bool success);
+//	```
+func (p precompileGov) voteWeighted(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+	proposalId, err := parseArgProposalId(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+	optionsArg, ok := args[1].([]struct {
+		Option uint8    `json:"option"`
+		Weight *big.Int `json:"weight"`
+	})
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("WeightedVoteOption[] options", args[1]))
+		return
+	}
+	metadata, ok := args[2].(string)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("string metadata", args[2]))
+		return
+	}
+
+	options := govv1.WeightedVoteOptions{}
+	for _, opt := range optionsArg {
+		if opt.Weight == nil {
+			err = ErrInvalidArgs(fmt.Errorf("weight of vote option %d is missing", opt.Option))
+			return
+		}
+		options = append(options, &govv1.WeightedVoteOption{
+			Option: govv1.VoteOption(opt.Option),
+			Weight: math.LegacyNewDecFromBigIntWithPrec(opt.Weight, math.LegacyPrecision).String(),
+		})
+	}
+
+	msg := govv1.NewMsgVoteWeighted(
+		eth.EthAddrToNibiruAddr(caller), proposalId, options, metadata,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	_, err = govkeeper.NewMsgServerImpl(&p.govKeeper).VoteWeighted(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "VoteWeighted", caller, proposalId, optionsArg,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// proposal: Implements "IGovernance.proposal"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function proposal(
+//	    uint64 proposalId
+//	) external view returns (Proposal memory info);
+//	```
+func (p precompileGov) proposal(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	proposalId, err := parseArgProposalId(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	proposal, found := p.govKeeper.GetProposal(ctx, proposalId)
+	if !found {
+		return nil, fmt.Errorf("proposal %d does not exist", proposalId)
+	}
+
+	totalDeposit := []struct {
+		Denom  string   `json:"denom"`
+		Amount *big.Int `json:"amount"`
+	}{}
+	for _, coin := range proposal.TotalDeposit {
+		totalDeposit = append(totalDeposit, struct {
+			Denom  string   `json:"denom"`
+			Amount *big.Int `json:"amount"`
+		}{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		})
+	}
+
+	return method.Outputs.Pack(struct {
+		Id           uint64 `json:"id"`
+		Status       uint8  `json:"status"`
+		Proposer     string `json:"proposer"`
+		Title        string `json:"title"`
+		Summary      string `json:"summary"`
+		Metadata     string `json:"metadata"`
+		TotalDeposit []struct {
+			Denom  string   `json:"denom"`
+			Amount *big.Int `json:"amount"`
+		} `json:"totalDeposit"`
+		SubmitTime      uint64 `json:"submitTime"`
+		DepositEndTime  uint64 `json:"depositEndTime"`
+		VotingStartTime uint64 `json:"votingStartTime"`
+		VotingEndTime   uint64 `json:"votingEndTime"`
+	}{
+		Id:              proposal.Id,
+		Status:          uint8(proposal.Status),
+		Proposer:        proposal.Proposer,
+		Title:           proposal.Title,
+		Summary:         proposal.Summary,
+		Metadata:        proposal.Metadata,
+		TotalDeposit:    totalDeposit,
+		SubmitTime:      unixSecondsOrZero(proposal.SubmitTime),
+		DepositEndTime:  unixSecondsOrZero(proposal.DepositEndTime),
+		VotingStartTime: unixSecondsOrZero(proposal.VotingStartTime),
+		VotingEndTime:   unixSecondsOrZero(proposal.VotingEndTime),
+	})
+}
+
+// tally: Implements "IGovernance.tally"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function tally(
+//	    uint64 proposalId
+//	) external view returns (TallyResult memory result);
+//	```
+func (p precompileGov) tally(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	proposalId, err := parseArgProposalId(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	proposal, found := p.govKeeper.GetProposal(ctx, proposalId)
+	if !found {
+		return nil, fmt.Errorf("proposal %d does not exist", proposalId)
+	}
+
+	tallyResult := govv1.EmptyTallyResult()
+	switch {
+	case proposal.Status == govv1.StatusVotingPeriod:
+		// "Tally" deletes the votes it counts, so it runs on a context whose
+		// writes are discarded.
+		tallyCtx, _ := ctx.CacheContext()
+		_, _, tallyResult = p.govKeeper.Tally(tallyCtx, proposal)
+	case proposal.FinalTallyResult != nil:
+		tallyResult = *proposal.FinalTallyResult
+	}
+
+	counts := make([]*big.Int, 4)
+	for idx, count := range []string{
+		tallyResult.YesCount,
+		tallyResult.AbstainCount,
+		tallyResult.NoCount,
+		tallyResult.NoWithVetoCount,
+	} {
+		countInt, ok := math.NewIntFromString(count)
+		if !ok {
+			return nil, fmt.Errorf("invalid tally count \"%s\"", count)
+		}
+		counts[idx] = countInt.BigInt()
+	}
+
+	return method.Outputs.Pack(struct {
+		Yes        *big.Int `json:"yes"`
+		Abstain    *big.Int `json:"abstain"`
+		No         *big.Int `json:"no"`
+		NoWithVeto *big.Int `json:"noWithVeto"`
+	}{
+		Yes:        counts[0],
+		Abstain:    counts[1],
+		No:         counts[2],
+		NoWithVeto: counts[3],
+	})
+}
+
+// parseArgProposalId parses a proposal ID from a "uint64" ABI argument.
+func parseArgProposalId(arg any) (proposalId uint64, err error) {
+	proposalId, ok := arg.(uint64)
+	if !ok {
+		return 0, ErrArgTypeValidation("uint64 proposalId", arg)
+	}
+	return proposalId, nil
+}
+
+// parseArgGovCoins parses valid [sdk.Coins] from a "BankCoin[]" ABI argument.
+func parseArgGovCoins(arg any, argName string) (coins sdk.Coins, err error) {
+	coinsArg, ok := arg.([]struct {
+		Denom  string   `json:"denom"`
+		Amount *big.Int `json:"amount"`
+	})
+	if !ok {
+		return nil, ErrArgTypeValidation(argName, arg)
+	}
+	for _, coin := range coinsArg {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf("amount of coin \"%s\" is missing", coin.Denom)
+		}
+		coins = append(coins, sdk.Coin{
+			Denom:  coin.Denom,
+			Amount: math.NewIntFromBigInt(coin.Amount),
+		})
+	}
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, err
+	}
+	return coins, nil
+}
+
+// unixSecondsOrZero returns the Unix time in seconds of "t", or 0 if "t" is
+// nil.
+func unixSecondsOrZero(t *time.Time) uint64 {
+	if t == nil {
+		return 0
+	}
+	return uint64(t.Unix())
+}
@@ -0,0 +1,386 @@
+package precompile_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile/test"
+)
+
+type GovSuite struct {
+	suite.Suite
+}
+
+// TestGovSuite: Runs all the tests in the suite.
+func TestGovSuite(t *testing.T) {
+	suite.Run(t, new(GovSuite))
+}
+
+// GovBankCoin mirrors the "IGovernance.BankCoin" struct for ABI packing.
+type GovBankCoin struct {
+	Denom  string   `abi:"denom"`
+	Amount *big.Int `abi:"amount"`
+}
+
+// GovWeightedVoteOption mirrors the "IGovernance.WeightedVoteOption" struct
+// for ABI packing.
+type GovWeightedVoteOption struct {
+	Option uint8    `abi:"option"`
+	Weight *big.Int `abi:"weight"`
+}
+
+// GovProposalReturn holds the return values from the "IGovernance.proposal"
+// method. The return bytes from successful calls of that method can be ABI
+// unpacked into this struct.
+type GovProposalReturn struct {
+	Info struct {
+		Id           uint64        `abi:"id"`
+		Status       uint8         `abi:"status"`
+		Proposer     string        `abi:"proposer"`
+		Title        string        `abi:"title"`
+		Summary      string        `abi:"summary"`
+		Metadata     string        `abi:"metadata"`
+		TotalDeposit []GovBankCoin `abi:"totalDeposit"`
+		SubmitTime   uint64        `abi:"submitTime"`
+
+		DepositEndTime  uint64 `abi:"depositEndTime"`
+		VotingStartTime uint64 `abi:"votingStartTime"`
+		VotingEndTime   uint64 `abi:"votingEndTime"`
+	} `abi:"info"`
+}
+
+// GovTallyReturn holds the return values from the "IGovernance.tally"
+// method. The return bytes from successful calls of that method can be ABI
+// unpacked into this struct.
+type GovTallyReturn struct {
+	Result struct {
+		Yes        *big.Int `abi:"yes"`
+		Abstain    *big.Int `abi:"abstain"`
+		No         *big.Int `abi:"no"`
+		NoWithVeto *big.Int `abi:"noWithVeto"`
+	} `abi:"result"`
+}
+
+func (s *GovSuite) TestFailToPackABI() {
+	testcases := []struct {
+		name       string
+		methodName string
+		callArgs   []any
+		wantError  string
+	}{
+		{
+			name:       "wrong amount of call args",
+			methodName: string(precompile.GovMethod_vote),
+			callArgs:   []any{uint64(1), uint8(1)},
+			wantError:  "argument count mismatch: got 2 for 3",
+		},
+		{
+			name:       "wrong type for proposal ID",
+			methodName: string(precompile.GovMethod_vote),
+			callArgs:   []any{big.NewInt(1), uint8(1), ""},
+			wantError:  "abi: cannot use ptr as type uint64 as argument",
+		},
+		{
+			name:       "wrong type for deposit",
+			methodName: string(precompile.GovMethod_deposit),
+			callArgs:   []any{uint64(1), "1unibi"},
+			wantError:  "abi: cannot use string as type [0]slice as argument",
+		},
+		{
+			name:       "invalid method name",
+			methodName: "foo",
+			callArgs:   []any{uint64(1)},
+			wantError:  "method 'foo' not found",
+		},
+	}
+
+	abi := embeds.SmartContract_Governance.ABI
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			input, err := abi.Pack(tc.methodName, tc.callArgs...)
+			s.ErrorContains(err, tc.wantError)
+			s.Nil(input)
+		})
+	}
+}
+
+func (s *GovSuite) TestHappyPath() {
+	deps := evmtest.NewTestDeps()
+	bondDenom := deps.App.StakingKeeper.BondDenom(deps.Ctx)
+	minDeposit := deps.App.GovKeeper.GetParams(deps.Ctx).MinDeposit
+	s.Require().Len(minDeposit, 1)
+	depositDenom := minDeposit[0].Denom
+	halfDeposit := minDeposit[0].Amount.QuoRaw(2)
+
+	s.T().Log("Fund sender and delegate to gain voting power")
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(
+			sdk.NewCoin(bondDenom, sdk.NewInt(1_000_000)),
+			sdk.NewCoin(depositDenom, minDeposit[0].Amount),
+		),
+	))
+	// Leftover gas fee is refunded within EthereumTx from the FeeCollector
+	// so, the module must have some coins
+	s.Require().NoError(testapp.FundModuleAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(1_000_000))),
+	))
+	validator := deps.App.StakingKeeper.GetValidators(deps.Ctx, 1)[0]
+	_, err := deps.App.StakingKeeper.Delegate(
+		deps.Ctx, deps.Sender.NibiruAddr, sdk.NewInt(1_000_000),
+		stakingtypes.Unbonded, validator, true,
+	)
+	s.Require().NoError(err)
+
+	callGov := func(method precompile.PrecompileMethod, args ...any) *evm.MsgEthereumTxResponse {
+		input, err := embeds.SmartContract_Governance.ABI.Pack(string(method), args...)
+		s.Require().NoError(err)
+		deps.ResetGasMeter()
+		_, ethTxResp, err := evmtest.CallContractTx(
+			&deps, precompile.PrecompileAddr_Governance, input, deps.Sender,
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(ethTxResp.VmError)
+		return ethTxResp
+	}
+	queryGov := func(out any, method precompile.PrecompileMethod, args ...any) {
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx,
+			embeds.SmartContract_Governance.ABI,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Governance,
+			false,
+			keeper.Erc20GasLimitExecute,
+			string(method),
+			args...,
+		)
+		s.Require().NoError(err, evmResp)
+		s.Require().NoError(embeds.SmartContract_Governance.ABI.UnpackIntoInterface(
+			out, string(method), evmResp.Ret,
+		))
+	}
+
+	var proposalId uint64
+	s.Run("IGovernance.submitProposal", func() {
+		s.Require().NoError(embeds.SmartContract_Governance.ABI.UnpackIntoInterface(
+			&proposalId, string(precompile.GovMethod_submitProposal),
+			callGov(
+				precompile.GovMethod_submitProposal,
+				"[]", "ipfs://proposal", "Text proposal", "Proposed from the EVM",
+				[]GovBankCoin{{Denom: depositDenom, Amount: halfDeposit.BigInt()}},
+			).Ret,
+		))
+
+		out := new(GovProposalReturn)
+		queryGov(out, precompile.GovMethod_proposal, proposalId)
+		s.Equal(proposalId, out.Info.Id)
+		s.Equal(uint8(govv1.StatusDepositPeriod), out.Info.Status)
+		s.Equal(deps.Sender.NibiruAddr.String(), out.Info.Proposer)
+		s.Equal("Text proposal", out.Info.Title)
+		s.Equal("Proposed from the EVM", out.Info.Summary)
+		s.Equal("ipfs://proposal", out.Info.Metadata)
+		s.Require().Len(out.Info.TotalDeposit, 1)
+		s.Equal(halfDeposit.String(), out.Info.TotalDeposit[0].Amount.String())
+		s.NotZero(out.Info.SubmitTime)
+		s.Zero(out.Info.VotingStartTime)
+	})
+
+	s.Run("IGovernance.deposit", func() {
+		ethTxResp := callGov(
+			precompile.GovMethod_deposit, proposalId,
+			[]GovBankCoin{{Denom: depositDenom, Amount: halfDeposit.BigInt()}},
+		)
+		eventLog, eventData := test.RequirePrecompileEventLog(
+			&s.Suite, ethTxResp.Logs, precompile.PrecompileAddr_Governance,
+			embeds.SmartContract_Governance.ABI, "Deposit",
+		)
+		s.Require().Len(eventLog.Topics, 3)
+		s.Equal(gethcommon.BytesToHash(deps.Sender.EthAddr.Bytes()), eventLog.Topics[1])
+		s.Equal(gethcommon.BigToHash(new(big.Int).SetUint64(proposalId)), eventLog.Topics[2])
+		s.Require().Len(eventData, 1)
+		s.Contains(fmt.Sprintf("%v", eventData[0]), halfDeposit.String())
+
+		out := new(GovProposalReturn)
+		queryGov(out, precompile.GovMethod_proposal, proposalId)
+		s.Equal(uint8(govv1.StatusVotingPeriod), out.Info.Status)
+		s.Equal(minDeposit[0].Amount.String(), out.Info.TotalDeposit[0].Amount.String())
+		s.NotZero(out.Info.VotingEndTime)
+	})
+
+	s.Run("IGovernance.vote", func() {
+		ethTxResp := callGov(precompile.GovMethod_vote, proposalId, uint8(govv1.OptionYes), "")
+		_, eventData := test.RequirePrecompileEventLog(
+			&s.Suite, ethTxResp.Logs, precompile.PrecompileAddr_Governance,
+			embeds.SmartContract_Governance.ABI, "Vote",
+		)
+		s.Equal([]any{uint8(govv1.OptionYes)}, eventData)
+
+		for i := 0; i < 2; i++ {
+			out := new(GovTallyReturn)
+			queryGov(out, precompile.GovMethod_tally, proposalId)
+			s.Equal("1000000", out.Result.Yes.String(), "querying the tally must not delete votes")
+			s.Equal("0", out.Result.No.String())
+		}
+	})
+
+	s.Run("IGovernance.voteWeighted", func() {
+		sixTenths, _ := new(big.Int).SetString("600000000000000000", 10)
+		fourTenths, _ := new(big.Int).SetString("400000000000000000", 10)
+		callGov(
+			precompile.GovMethod_voteWeighted, proposalId,
+			[]GovWeightedVoteOption{
+				{Option: uint8(govv1.OptionYes), Weight: sixTenths},
+				{Option: uint8(govv1.OptionNo), Weight: fourTenths},
+			},
+			"split",
+		)
+
+		vote, found := deps.App.GovKeeper.GetVote(deps.Ctx, proposalId, deps.Sender.NibiruAddr)
+		s.Require().True(found)
+		s.Equal("split", vote.Metadata)
+		s.Require().Len(vote.Options, 2)
+
+		out := new(GovTallyReturn)
+		queryGov(out, precompile.GovMethod_tally, proposalId)
+		s.Equal("600000", out.Result.Yes.String())
+		s.Equal("400000", out.Result.No.String())
+		s.Equal("0", out.Result.Abstain.String())
+		s.Equal("0", out.Result.NoWithVeto.String())
+	})
+}
+
+func (s *GovSuite) TestSadPaths() {
+	deps := evmtest.NewTestDeps()
+	depositDenom := deps.App.GovKeeper.GetParams(deps.Ctx).MinDeposit[0].Denom
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewCoin(depositDenom, sdk.NewInt(1_000))),
+	))
+	deposit := []GovBankCoin{{Denom: depositDenom, Amount: big.NewInt(1)}}
+
+	for _, tc := range []struct {
+		name      string
+		method    precompile.PrecompileMethod
+		args      []any
+		wantError string
+	}{
+		{
+			name:      "msgsJSON is not a JSON array",
+			method:    precompile.GovMethod_submitProposal,
+			args:      []any{"{}", "", "title", "summary", deposit},
+			wantError: "msgsJSON must be a JSON array of messages",
+		},
+		{
+			name:   "unknown message type",
+			method: precompile.GovMethod_submitProposal,
+			args: []any{
+				`[{"@type": "/nibiru.foo.v1.MsgFoo"}]`, "", "title", "summary", deposit,
+			},
+			wantError: "failed to decode proposal message 0",
+		},
+		{
+			name:   "Ethereum tx in proposal messages",
+			method: precompile.GovMethod_submitProposal,
+			args: []any{
+				`[{"@type": "/eth.evm.v1.MsgEthereumTx"}]`, "", "title", "summary", deposit,
+			},
+			wantError: "proposal messages cannot contain /eth.evm.v1.MsgEthereumTx",
+		},
+		{
+			name:   "Ethereum tx in authz exec of proposal messages",
+			method: precompile.GovMethod_submitProposal,
+			args: []any{
+				fmt.Sprintf(
+					`[{"@type": "/cosmos.authz.v1beta1.MsgExec", "grantee": "%s", "msgs": [{"@type": "/eth.evm.v1.MsgEthereumTx"}]}]`,
+					authtypes.NewModuleAddress("gov"),
+				),
+				"", "title", "summary", deposit,
+			},
+			wantError: "ExtensionOptionsEthereumTx",
+		},
+		{
+			name:   "proposal message not signed by gov",
+			method: precompile.GovMethod_submitProposal,
+			args: []any{
+				fmt.Sprintf(
+					`[{"@type": "/cosmos.bank.v1beta1.MsgSend", "from_address": "%s", "to_address": "%s", "amount": [{"denom": "%s", "amount": "1"}]}]`,
+					deps.Sender.NibiruAddr, testutil.AccAddress(), depositDenom,
+				),
+				"", "title", "summary", deposit,
+			},
+			wantError: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:      "missing title",
+			method:    precompile.GovMethod_submitProposal,
+			args:      []any{"[]", "metadata", "", "summary", deposit},
+			wantError: "proposal title cannot be empty",
+		},
+		{
+			name:      "deposit to missing proposal",
+			method:    precompile.GovMethod_deposit,
+			args:      []any{uint64(420), deposit},
+			wantError: "420: unknown proposal",
+		},
+		{
+			name:      "invalid vote option",
+			method:    precompile.GovMethod_vote,
+			args:      []any{uint64(420), uint8(9), ""},
+			wantError: "invalid vote option",
+		},
+		{
+			name:      "vote on missing proposal",
+			method:    precompile.GovMethod_vote,
+			args:      []any{uint64(420), uint8(govv1.OptionYes), ""},
+			wantError: "420: inactive proposal",
+		},
+	} {
+		s.Run(tc.name, func() {
+			input, err := embeds.SmartContract_Governance.ABI.Pack(
+				string(tc.method), tc.args...,
+			)
+			s.Require().NoError(err)
+			deps.ResetGasMeter()
+			_, _, err = evmtest.CallContractTx(
+				&deps, precompile.PrecompileAddr_Governance, input, deps.Sender,
+			)
+			s.Require().ErrorContains(err, tc.wantError)
+		})
+	}
+
+	s.T().Log("Querying a missing proposal fails")
+	_, err := deps.EvmKeeper.CallContract(
+		deps.Ctx,
+		embeds.SmartContract_Governance.ABI,
+		deps.Sender.EthAddr,
+		&precompile.PrecompileAddr_Governance,
+		false,
+		keeper.Erc20GasLimitExecute,
+		string(precompile.GovMethod_proposal),
+		uint64(420),
+	)
+	s.Require().ErrorContains(err, "proposal 420 does not exist")
+}
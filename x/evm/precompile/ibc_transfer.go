@@ -0,0 +1,214 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v7/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+var _ vm.PrecompiledContract = (*precompileIBCTransfer)(nil)
+
+// Precompile address for "IIBCTransfer.sol", the contract that enables ICS-20
+// transfers of bank coins and FunToken-mapped ERC20s from EVM accounts.
+var PrecompileAddr_IBCTransfer = gethcommon.HexToAddress("0x0000000000000000000000000000000000000804")
+
+func (p precompileIBCTransfer) Address() gethcommon.Address {
+	return PrecompileAddr_IBCTransfer
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileIBCTransfer) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileIBCTransfer) ABI() *gethabi.ABI {
+	return embeds.SmartContract_IBCTransfer.ABI
+}
+
+const (
+	IBCTransferMethod_transfer PrecompileMethod = "transfer"
+)
+
+// Run runs the precompiled contract
+func (p precompileIBCTransfer) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	return runPrecompile(p, evm, contract, readonly, p.evmKeeper.Bank, map[PrecompileMethod]precompileMethod{
+		IBCTransferMethod_transfer: p.transfer,
+	})
+}
+
+func PrecompileIBCTransfer(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileIBCTransfer{
+		evmKeeper:      keepers.EvmKeeper,
+		transferKeeper: keepers.IBCTransferKeeper,
+	}
+}
+
+type precompileIBCTransfer struct {
+	evmKeeper      *evmkeeper.Keeper
+	transferKeeper ibctransferkeeper.Keeper
+}
+
+// transfer: Implements "IIBCTransfer.transfer"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function transfer(
+//	    string memory channel,
+//	    string memory token,
+//	    uint256 amount,
+//	    string memory receiver,
+//	    uint64 timeoutTimestamp
+//	) external returns (uint64 sequence);
+//	```
+//
+// If "token" is the hex address of an ERC20 with a FunToken mapping, the
+// ERC20 tokens of the caller are first converted to the mapped bank coin,
+// exactly as in "IFunToken.sendToBank" with the caller as the recipient.
+func (p precompileIBCTransfer) transfer(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	channel, token, amount, receiver, timeoutTimestamp, err := p.parseArgsTransfer(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	if !p.evmKeeper.GetParams(ctx).IsEVMChannel(channel) {
+		return nil, fmt.Errorf(
+			"channel \"%s\" is not one of the EVM channels allowed by the EVM module params", channel,
+		)
+	}
+
+	callerBech32 := eth.EthAddrToNibiruAddr(caller)
+	var coin sdk.Coin
+	if gethcommon.IsHexAddress(token) {
+		erc20 := gethcommon.HexToAddress(token)
+		funtokens := p.evmKeeper.FunTokens.Collect(
+			ctx, p.evmKeeper.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20),
+		)
+		if len(funtokens) != 1 {
+			return nil, fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20.Hex())
+		}
+		funtoken := funtokens[0]
+
+		gotAmount, e := sendERC20ToBank(start, p.Address(), p.evmKeeper, funtoken, caller, amount, callerBech32)
+		if e != nil {
+			return nil, e
+		}
+		coin = sdk.NewCoin(funtoken.BankDenom, math.NewIntFromBigInt(gotAmount))
+	} else {
+		if e := sdk.ValidateDenom(token); e != nil {
+			return nil, ErrInvalidArgs(fmt.Errorf(
+				"\"token\" is neither an ERC20 address nor a valid bank denom (%s): %w", token, e,
+			))
+		}
+		coin = sdk.NewCoin(token, math.NewIntFromBigInt(amount))
+	}
+
+	msg := &ibctransfertypes.MsgTransfer{
+		SourcePort:       ibctransfertypes.PortID,
+		SourceChannel:    channel,
+		Token:            coin,
+		Sender:           callerBech32.String(),
+		Receiver:         receiver,
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	var resp *ibctransfertypes.MsgTransferResponse
+	_, err = traceCosmosCall(start, p.Address(), sdk.MsgTypeURL(msg), msg, func() ([]byte, error) {
+		var e error
+		resp, e = p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+		return nil, e
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "IBCTransfer",
+		caller, channel, coin.Denom, coin.Amount.BigInt(), receiver, resp.Sequence,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(resp.Sequence)
+}
+
+func (p precompileIBCTransfer) parseArgsTransfer(args []any) (
+	channel string,
+	token string,
+	amount *big.Int,
+	receiver string,
+	timeoutTimestamp uint64,
+	err error,
+) {
+	if e := assertNumArgs(args, 5); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	channel, ok := args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string channel", args[argIdx])
+		return
+	}
+
+	argIdx++
+	token, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string token", args[argIdx])
+		return
+	}
+
+	argIdx++
+	amount, ok = args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[argIdx])
+		return
+	}
+	if amount == nil || amount.Sign() != 1 {
+		err = fmt.Errorf("transfer amount must be positive")
+		return
+	}
+
+	argIdx++
+	receiver, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string receiver", args[argIdx])
+		return
+	}
+
+	argIdx++
+	timeoutTimestamp, ok = args[argIdx].(uint64)
+	if !ok {
+		err = ErrArgTypeValidation("uint64 timeoutTimestamp", args[argIdx])
+		return
+	}
+	if timeoutTimestamp == 0 {
+		err = fmt.Errorf("timeoutTimestamp must be nonzero")
+		return
+	}
+
+	return channel, token, amount, receiver, timeoutTimestamp, nil
+}
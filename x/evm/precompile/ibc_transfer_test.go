@@ -0,0 +1,146 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+type IBCTransferSuite struct {
+	suite.Suite
+}
+
+// TestIBCTransferSuite: Runs all the tests in the suite.
+func TestIBCTransferSuite(t *testing.T) {
+	suite.Run(t, new(IBCTransferSuite))
+}
+
+func (s *IBCTransferSuite) TestFailToPackABI() {
+	testcases := []struct {
+		name       string
+		methodName string
+		callArgs   []any
+		wantError  string
+	}{
+		{
+			name:       "wrong amount of call args",
+			methodName: string(precompile.IBCTransferMethod_transfer),
+			callArgs:   []any{"channel-0", "unibi", big.NewInt(1)},
+			wantError:  "argument count mismatch: got 3 for 5",
+		},
+		{
+			name:       "wrong type for amount",
+			methodName: string(precompile.IBCTransferMethod_transfer),
+			callArgs:   []any{"channel-0", "unibi", "foo", "cosmos1", uint64(1)},
+			wantError:  "abi: cannot use string as type ptr as argument",
+		},
+		{
+			name:       "wrong type for timeout",
+			methodName: string(precompile.IBCTransferMethod_transfer),
+			callArgs:   []any{"channel-0", "unibi", big.NewInt(1), "cosmos1", big.NewInt(1)},
+			wantError:  "abi: cannot use ptr as type uint64 as argument",
+		},
+		{
+			name:       "invalid method name",
+			methodName: "foo",
+			callArgs:   []any{"channel-0", "unibi", big.NewInt(1), "cosmos1", uint64(1)},
+			wantError:  "method 'foo' not found",
+		},
+	}
+
+	abi := embeds.SmartContract_IBCTransfer.ABI
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			input, err := abi.Pack(tc.methodName, tc.callArgs...)
+			s.ErrorContains(err, tc.wantError)
+			s.Nil(input)
+		})
+	}
+}
+
+func (s *IBCTransferSuite) TestSadPaths() {
+	deps := evmtest.NewTestDeps()
+	evmChannel := "channel-0"
+	params := deps.EvmKeeper.GetParams(deps.Ctx)
+	params.EVMChannels = []string{evmChannel}
+	s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx, params))
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewCoin("ibcfoo", sdk.NewInt(1000))),
+	))
+
+	receiver := testutil.AccAddress().String()
+	for _, tc := range []struct {
+		name      string
+		args      []any
+		wantError string
+	}{
+		{
+			name:      "channel not in evm channels",
+			args:      []any{"channel-1", "ibcfoo", big.NewInt(1), receiver, uint64(1)},
+			wantError: "is not one of the EVM channels",
+		},
+		{
+			name:      "amount must be positive",
+			args:      []any{evmChannel, "ibcfoo", big.NewInt(0), receiver, uint64(1)},
+			wantError: "transfer amount must be positive",
+		},
+		{
+			name:      "timeout must be nonzero",
+			args:      []any{evmChannel, "ibcfoo", big.NewInt(1), receiver, uint64(0)},
+			wantError: "timeoutTimestamp must be nonzero",
+		},
+		{
+			name:      "token is neither an ERC20 nor a bank denom",
+			args:      []any{evmChannel, "0xnot-a-denom", big.NewInt(1), receiver, uint64(1)},
+			wantError: "is neither an ERC20 address nor a valid bank denom",
+		},
+		{
+			name: "ERC20 without a FunToken mapping",
+			args: []any{
+				evmChannel,
+				gethcommon.HexToAddress("0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6").Hex(),
+				big.NewInt(1), receiver, uint64(1),
+			},
+			wantError: "no FunToken mapping exists for ERC20",
+		},
+		{
+			name:      "missing receiver",
+			args:      []any{evmChannel, "ibcfoo", big.NewInt(1), " ", uint64(1)},
+			wantError: "missing recipient address",
+		},
+		{
+			name:      "channel does not exist",
+			args:      []any{evmChannel, "ibcfoo", big.NewInt(1), receiver, uint64(1)},
+			wantError: "channel not found",
+		},
+	} {
+		s.Run(tc.name, func() {
+			_, err := deps.EvmKeeper.CallContract(
+				deps.Ctx,
+				embeds.SmartContract_IBCTransfer.ABI,
+				deps.Sender.EthAddr,
+				&precompile.PrecompileAddr_IBCTransfer,
+				true,
+				keeper.Erc20GasLimitExecute,
+				string(precompile.IBCTransferMethod_transfer),
+				tc.args...,
+			)
+			s.Require().ErrorContains(err, tc.wantError)
+		})
+	}
+}
@@ -1,8 +1,9 @@
 package precompile
 
 import (
-	"fmt"
+	"math/big"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -32,37 +33,58 @@ func (p precompileOracle) ABI() *gethabi.ABI {
 }
 
 const (
-	OracleMethod_queryExchangeRate PrecompileMethod = "queryExchangeRate"
+	OracleMethod_queryExchangeRate     PrecompileMethod = "queryExchangeRate"
+	OracleMethod_queryExchangeRateTwap PrecompileMethod = "queryExchangeRateTwap"
+	OracleMethod_queryExchangeRates    PrecompileMethod = "queryExchangeRates"
+	OracleMethod_queryActivePairs      PrecompileMethod = "queryActivePairs"
+	OracleMethod_decimals              PrecompileMethod = "decimals"
+	OracleMethod_latestRoundData       PrecompileMethod = "latestRoundData"
 )
 
+// oracleKeeperCalls are the oracle keeper reads of the precompile methods. They
+// name the child frames reported to the active tracer, which record the pair
+// queried and any error from the oracle module, such as a missing price.
+var oracleKeeperCalls = map[PrecompileMethod]string{
+	OracleMethod_queryExchangeRate:     "oracle.GetDatedExchangeRate",
+	OracleMethod_queryExchangeRateTwap: "oracle.GetExchangeRateTwap",
+	OracleMethod_queryExchangeRates:    "oracle.ExchangeRates",
+	OracleMethod_queryActivePairs:      "oracle.ExchangeRates",
+	OracleMethod_latestRoundData:       "oracle.GetDatedExchangeRate",
+}
+
+// OraclePriceDecimals is the number of decimals of the prices returned by the
+// oracle precompile. Exchange rates are [sdk.Dec] values, which have 18
+// decimals of precision.
+const OraclePriceDecimals uint8 = sdk.Precision
+
 // Run runs the precompiled contract
 func (p precompileOracle) Run(
 	evm *vm.EVM, contract *vm.Contract, readonly bool,
 ) (bz []byte, err error) {
-	defer func() {
-		err = ErrPrecompileRun(err, p)
-	}()
-	startResult, err := OnRunStart(evm, contract.Input, p.ABI(), contract.Gas)
-	if err != nil {
-		return nil, err
+	methods := map[PrecompileMethod]precompileMethod{
+		OracleMethod_queryExchangeRate:     p.queryExchangeRate,
+		OracleMethod_queryExchangeRateTwap: p.queryExchangeRateTwap,
+		OracleMethod_queryExchangeRates:    p.queryExchangeRates,
+		OracleMethod_queryActivePairs:      p.queryActivePairs,
+		OracleMethod_decimals:              p.decimals,
+		OracleMethod_latestRoundData:       p.latestRoundData,
 	}
-	method, args, ctx := startResult.Method, startResult.Args, startResult.CacheCtx
-
-	switch PrecompileMethod(method.Name) {
-	case OracleMethod_queryExchangeRate:
-		bz, err = p.queryExchangeRate(ctx, method, args)
-	default:
-		// Note that this code path should be impossible to reach since
-		// "[decomposeInput]" parses methods directly from the ABI.
-		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
-		return
-	}
-	if err != nil {
-		return nil, err
+	for methodName, keeperCall := range oracleKeeperCalls {
+		methods[methodName] = p.traceKeeperCall(keeperCall, methods[methodName])
 	}
+	return runPrecompile(p, evm, contract, readonly, nil, methods)
+}
 
-	contract.UseGas(startResult.CacheCtx.GasMeter().GasConsumed())
-	return bz, err
+// traceKeeperCall reports "run" to the active tracer as a child frame of the
+// precompile call named "keeperCall".
+func (p precompileOracle) traceKeeperCall(
+	keeperCall string, run precompileMethod,
+) precompileMethod {
+	return func(start OnRunStartResult, caller gethcommon.Address) ([]byte, error) {
+		return traceCosmosCall(start, p.Address(), keeperCall, start.Args, func() ([]byte, error) {
+			return run(start, caller)
+		})
+	}
 }
 
 func PrecompileOracle(keepers keepers.PublicKeepers) vm.PrecompiledContract {
@@ -76,10 +98,10 @@ type precompileOracle struct {
 }
 
 func (p precompileOracle) queryExchangeRate(
-	ctx sdk.Context,
-	method *gethabi.Method,
-	args []any,
+	start OnRunStartResult,
+	_ gethcommon.Address,
 ) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
 	pair, err := p.parseQueryExchangeRateArgs(args)
 	if err != nil {
 		return nil, err
@@ -114,3 +136,165 @@ func (p precompileOracle) parseQueryExchangeRateArgs(args []any) (
 
 	return pair, nil
 }
+
+// queryExchangeRateTwap: Implements "IOracle.queryExchangeRateTwap"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function queryExchangeRateTwap(
+//	    string memory pair
+//	) external view returns (uint256 price);
+//	```
+func (p precompileOracle) queryExchangeRateTwap(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+	pair, err := p.parseQueryExchangeRateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	assetPair, err := asset.TryNewPair(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := p.oracleKeeper.GetExchangeRateTwap(ctx, assetPair)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(price.BigInt())
+}
+
+// queryExchangeRates: Implements "IOracle.queryExchangeRates"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function queryExchangeRates()
+//	    external view returns (DatedExchangeRate[] memory rates);
+//	```
+func (p precompileOracle) queryExchangeRates(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+	if err := assertNumArgs(args, 0); err != nil {
+		return nil, err
+	}
+
+	type datedExchangeRate struct {
+		Pair        string   `json:"pair"`
+		Price       *big.Int `json:"price"`
+		BlockTimeMs uint64   `json:"blockTimeMs"`
+		BlockHeight uint64   `json:"blockHeight"`
+	}
+	rates := []datedExchangeRate{}
+	for _, pair := range p.oracleKeeper.ExchangeRates.Iterate(
+		ctx, collections.Range[asset.Pair]{},
+	).Keys() {
+		price, blockTime, blockHeight, err := p.oracleKeeper.GetDatedExchangeRate(ctx, pair)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, datedExchangeRate{
+			Pair:        pair.String(),
+			Price:       price.BigInt(),
+			BlockTimeMs: uint64(blockTime),
+			BlockHeight: blockHeight,
+		})
+	}
+
+	return method.Outputs.Pack(rates)
+}
+
+// queryActivePairs: Implements "IOracle.queryActivePairs"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function queryActivePairs() external view returns (string[] memory pairs);
+//	```
+func (p precompileOracle) queryActivePairs(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+	if err := assertNumArgs(args, 0); err != nil {
+		return nil, err
+	}
+
+	pairs := []string{}
+	for _, pair := range p.oracleKeeper.ExchangeRates.Iterate(
+		ctx, collections.Range[asset.Pair]{},
+	).Keys() {
+		pairs = append(pairs, pair.String())
+	}
+
+	return method.Outputs.Pack(pairs)
+}
+
+// decimals: Implements "IOracle.decimals"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function decimals() external view returns (uint8);
+//	```
+func (p precompileOracle) decimals(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args := start.Method, start.Args
+	if err := assertNumArgs(args, 0); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(OraclePriceDecimals)
+}
+
+// latestRoundData: Implements "IOracle.latestRoundData"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function latestRoundData(
+//	    string memory pair
+//	) external view returns (
+//	    uint80 roundId,
+//	    int256 answer,
+//	    uint256 startedAt,
+//	    uint256 updatedAt,
+//	    uint80 answeredInRound
+//	);
+//	```
+//
+// The return values match the Chainlink "AggregatorV3Interface", so that
+// existing price-feed code can read Nibiru prices. Since the oracle has no
+// rounds, the block height of the latest price is used as the round ID.
+func (p precompileOracle) latestRoundData(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+	pair, err := p.parseQueryExchangeRateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	assetPair, err := asset.TryNewPair(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	price, blockTimeMs, blockHeight, err := p.oracleKeeper.GetDatedExchangeRate(ctx, assetPair)
+	if err != nil {
+		return nil, err
+	}
+
+	roundId := new(big.Int).SetUint64(blockHeight)
+	updatedAt := big.NewInt(blockTimeMs / 1000)
+	return method.Outputs.Pack(
+		roundId, price.BigInt(), updatedAt, updatedAt, roundId,
+	)
+}
@@ -83,6 +83,199 @@ func (s *OracleSuite) TestOracle_HappyPath() {
 		s.Equal(out[1].(uint64), uint64(69000))
 		s.Equal(out[2].(uint64), uint64(69))
 	}
+
+	queryOracle := func(method precompile.PrecompileMethod, args ...any) []any {
+		resp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx,
+			embeds.SmartContract_Oracle.ABI,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Oracle,
+			false,
+			OracleGasLimitQuery,
+			string(method),
+			args...,
+		)
+		s.Require().NoError(err)
+		out, err := embeds.SmartContract_Oracle.ABI.Unpack(string(method), resp.Ret)
+		s.Require().NoError(err)
+		return out
+	}
+
+	s.T().Log("Query exchange rate TWAP")
+	{
+		deps.Ctx = deps.Ctx.WithBlockTime(time.Unix(79, 420)).WithBlockHeight(70)
+		deps.App.OracleKeeper.SetPrice(deps.Ctx, "unibi:uusd", sdk.MustNewDecFromStr("0.077"))
+		deps.Ctx = deps.Ctx.WithBlockTime(time.Unix(89, 420)).WithBlockHeight(71)
+
+		out := queryOracle(precompile.OracleMethod_queryExchangeRateTwap, "unibi:uusd")
+		// TWAP of 0.067 for 10 seconds and 0.077 for 10 seconds
+		s.Equal(big.NewInt(72000000000000000), out[0].(*big.Int))
+	}
+
+	s.T().Log("Query exchange rates and active pairs")
+	{
+		deps.App.OracleKeeper.SetPrice(deps.Ctx, "ubtc:uusd", sdk.MustNewDecFromStr("69420"))
+
+		out := queryOracle(precompile.OracleMethod_queryActivePairs)
+		pairs := out[0].([]string)
+		s.Contains(pairs, "ubtc:uusd")
+		s.Contains(pairs, "unibi:uusd")
+
+		resp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx,
+			embeds.SmartContract_Oracle.ABI,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Oracle,
+			false,
+			OracleGasLimitQuery,
+			string(precompile.OracleMethod_queryExchangeRates),
+		)
+		s.Require().NoError(err)
+		rates := new(OracleExchangeRatesReturn)
+		s.Require().NoError(embeds.SmartContract_Oracle.ABI.UnpackIntoInterface(
+			rates, string(precompile.OracleMethod_queryExchangeRates), resp.Ret,
+		))
+		s.Require().Len(rates.Rates, len(pairs))
+		for _, rate := range rates.Rates {
+			switch rate.Pair {
+			case "ubtc:uusd":
+				s.Equal("69420000000000000000000", rate.Price.String())
+				s.Equal(uint64(71), rate.BlockHeight)
+			case "unibi:uusd":
+				s.Equal("77000000000000000", rate.Price.String())
+				s.Equal(uint64(70), rate.BlockHeight)
+			}
+		}
+	}
+
+	s.T().Log("Query Chainlink AggregatorV3 compatible price")
+	{
+		out := queryOracle(precompile.OracleMethod_decimals)
+		s.Equal(uint8(18), out[0].(uint8))
+
+		out = queryOracle(precompile.OracleMethod_latestRoundData, "ubtc:uusd")
+		s.Equal(big.NewInt(71), out[0].(*big.Int), "roundId")
+		wantAnswer, _ := new(big.Int).SetString("69420000000000000000000", 10)
+		s.Equal(wantAnswer, out[1].(*big.Int), "answer")
+		s.Equal(big.NewInt(89), out[2].(*big.Int), "startedAt")
+		s.Equal(big.NewInt(89), out[3].(*big.Int), "updatedAt")
+		s.Equal(big.NewInt(71), out[4].(*big.Int), "answeredInRound")
+	}
+}
+
+func (s *OracleSuite) TestOracle_SadPaths() {
+	deps := evmtest.NewTestDeps()
+
+	for _, tc := range []struct {
+		name      string
+		method    precompile.PrecompileMethod
+		wantError string
+	}{
+		{
+			name:      "no price for pair",
+			method:    precompile.OracleMethod_latestRoundData,
+			wantError: "not found",
+		},
+		{
+			name:      "no snapshots for TWAP",
+			method:    precompile.OracleMethod_queryExchangeRateTwap,
+			wantError: "no snapshots for pair",
+		},
+	} {
+		s.Run(tc.name, func() {
+			_, err := deps.EvmKeeper.CallContract(
+				deps.Ctx,
+				embeds.SmartContract_Oracle.ABI,
+				deps.Sender.EthAddr,
+				&precompile.PrecompileAddr_Oracle,
+				false,
+				OracleGasLimitQuery,
+				string(tc.method),
+				"ubtc:uusd",
+			)
+			s.Require().ErrorContains(err, tc.wantError)
+		})
+	}
+}
+
+func (s *OracleSuite) TestOracle_ChainLinkLikeAdapter() {
+	deps := evmtest.NewTestDeps()
+	deps.Ctx = deps.Ctx.WithBlockTime(time.Unix(69, 420)).WithBlockHeight(69)
+	deps.App.OracleKeeper.SetPrice(deps.Ctx, "ubtc:uusd", sdk.MustNewDecFromStr("69420"))
+
+	s.T().Log("Deploy the adapter for ubtc:uusd")
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_NibiruOracleChainLinkLike, "ubtc:uusd",
+	)
+	s.Require().NoError(err)
+	adapter := embeds.SmartContract_NibiruOracleChainLinkLike.ABI
+
+	queryAdapter := func(method string, args ...any) ([]any, error) {
+		resp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx,
+			adapter,
+			deps.Sender.EthAddr,
+			&deployResp.ContractAddr,
+			false,
+			OracleGasLimitQuery,
+			method,
+			args...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return adapter.Unpack(method, resp.Ret)
+	}
+
+	out, err := queryAdapter("decimals")
+	s.Require().NoError(err)
+	s.Equal(precompile.OraclePriceDecimals, out[0].(uint8))
+
+	out, err = queryAdapter("description")
+	s.Require().NoError(err)
+	s.Equal("ubtc:uusd", out[0].(string))
+
+	out, err = queryAdapter("version")
+	s.Require().NoError(err)
+	s.Equal(big.NewInt(1), out[0].(*big.Int))
+
+	s.T().Log("Read the price with latestRoundData")
+	wantAnswer, _ := new(big.Int).SetString("69420000000000000000000", 10)
+	out, err = queryAdapter("latestRoundData")
+	s.Require().NoError(err)
+	s.Equal(big.NewInt(69), out[0].(*big.Int), "roundId")
+	s.Equal(wantAnswer, out[1].(*big.Int), "answer")
+	s.Equal(big.NewInt(69), out[2].(*big.Int), "startedAt")
+	s.Equal(big.NewInt(69), out[3].(*big.Int), "updatedAt")
+	s.Equal(big.NewInt(69), out[4].(*big.Int), "answeredInRound")
+
+	s.T().Log("getRoundData only has the latest round")
+	out, err = queryAdapter("getRoundData", big.NewInt(69))
+	s.Require().NoError(err)
+	s.Equal(wantAnswer, out[1].(*big.Int), "answer")
+
+	_, err = queryAdapter("getRoundData", big.NewInt(68))
+	s.Require().ErrorContains(err, "No data present")
+
+	s.T().Log("The adapter reverts for a pair without price")
+	deployResp, err = evmtest.DeployContract(
+		&deps, embeds.SmartContract_NibiruOracleChainLinkLike, "ueth:uusd",
+	)
+	s.Require().NoError(err)
+	_, err = queryAdapter("latestRoundData")
+	s.Require().ErrorContains(err, "execution reverted")
+}
+
+// OracleExchangeRatesReturn holds the return values from the
+// "IOracle.queryExchangeRates" method. The return bytes from successful calls
+// of that method can be ABI unpacked into this struct.
+type OracleExchangeRatesReturn struct {
+	Rates []struct {
+		Pair        string   `abi:"pair"`
+		Price       *big.Int `abi:"price"`
+		BlockTimeMs uint64   `abi:"blockTimeMs"`
+		BlockHeight uint64   `abi:"blockHeight"`
+	} `abi:"rates"`
 }
 
 type OracleSuite struct {
@@ -0,0 +1,132 @@
+package precompile_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+type P256Suite struct {
+	suite.Suite
+}
+
+// TestP256Suite: Runs all the tests in the suite.
+func TestP256Suite(t *testing.T) {
+	suite.Run(t, new(P256Suite))
+}
+
+// p256VerifyInput builds the 160-byte "P256VERIFY" input from a fresh key and
+// signature over the given message.
+func p256VerifyInput(s *suite.Suite, msg []byte) []byte {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	hash := sha256.Sum256(msg)
+	r, sig, err := ecdsa.Sign(rand.Reader, privKey, hash[:])
+	s.Require().NoError(err)
+
+	input := append([]byte{}, hash[:]...)
+	input = append(input, gethcommon.LeftPadBytes(r.Bytes(), 32)...)
+	input = append(input, gethcommon.LeftPadBytes(sig.Bytes(), 32)...)
+	input = append(input, gethcommon.LeftPadBytes(privKey.X.Bytes(), 32)...)
+	input = append(input, gethcommon.LeftPadBytes(privKey.Y.Bytes(), 32)...)
+	return input
+}
+
+func (s *P256Suite) TestRun() {
+	p := precompile.PrecompileP256Verify()
+	success := gethcommon.LeftPadBytes([]byte{1}, 32)
+	validInput := p256VerifyInput(&s.Suite, []byte("nibiru passkey"))
+
+	tamper := func(idx int) []byte {
+		input := append([]byte{}, validInput...)
+		input[idx] ^= 0x01
+		return input
+	}
+
+	for _, tc := range []struct {
+		name     string
+		inputHex string
+		input    []byte
+		wantOut  []byte
+	}{
+		{
+			// Test vector from the RIP-7212 specification
+			name:     "RIP-7212 vector: valid signature",
+			inputHex: "4cee90eb86eaa050036147a12d49004b6b9c72bd725d39d4785011fe190f0b4da73bd4903f0ce3b639bbbf6e8e80d16931ff4bcf5993d58468e8fb19086e8cac36dbcd03009df8c59286b162af3bd7fcc0450c9aa81be5d10d312af6c66b1d604aebd3099c618202fcfe16ae7770b0c49ab5eadf74b754204a3bb6060e44eff37618b065f9832de4ca6ca971a7a1adc826d0f7c00181a5fb2ddf79ae00b4e10e",
+			wantOut:  success,
+		},
+		{
+			name:    "valid signature",
+			input:   validInput,
+			wantOut: success,
+		},
+		{name: "tampered hash", input: tamper(0)},
+		{name: "tampered r", input: tamper(63)},
+		{name: "tampered s", input: tamper(95)},
+		{name: "public key not on curve", input: tamper(159)},
+		{name: "input too short", input: validInput[:159]},
+		{name: "input too long", input: append(append([]byte{}, validInput...), 0)},
+		{name: "empty input", input: []byte{}},
+		{name: "zero signature and key", input: make([]byte, 160)},
+	} {
+		s.Run(tc.name, func() {
+			input := tc.input
+			if tc.inputHex != "" {
+				input = gethcommon.FromHex(tc.inputHex)
+			}
+			s.Equal(precompile.P256VerifyGas, p.RequiredGas(input))
+
+			out, err := p.Run(nil, &vm.Contract{Input: input}, false)
+			s.NoError(err, "P256VERIFY must never return an error")
+			s.Equal(tc.wantOut, out)
+		})
+	}
+}
+
+func (s *P256Suite) TestCallFromEVM() {
+	deps := evmtest.NewTestDeps()
+	s.True(deps.EvmKeeper.IsPrecompile(precompile.PrecompileAddr_P256Verify))
+
+	for _, tc := range []struct {
+		name    string
+		input   []byte
+		wantRet []byte
+	}{
+		{
+			name:    "valid signature",
+			input:   p256VerifyInput(&s.Suite, []byte("nibiru passkey")),
+			wantRet: gethcommon.LeftPadBytes([]byte{1}, 32),
+		},
+		{
+			name:  "invalid input does not revert",
+			input: []byte("not a P256VERIFY input"),
+		},
+	} {
+		s.Run(tc.name, func() {
+			evmResp, _, err := deps.EvmKeeper.CallContractWithInput(
+				deps.Ctx,
+				deps.Sender.EthAddr,
+				&precompile.PrecompileAddr_P256Verify,
+				false,
+				tc.input,
+				100_000,
+			)
+			s.Require().NoError(err)
+			s.Empty(evmResp.VmError)
+			if tc.wantRet == nil {
+				s.Empty(evmResp.Ret)
+				return
+			}
+			s.Equal(tc.wantRet, evmResp.Ret)
+		})
+	}
+}
@@ -8,6 +8,12 @@
 // Key components:
 //   - InitPrecompiles: Initializes and returns a map of precompiled contracts.
 //   - PrecompileFunToken: Implements the FunToken precompile for ERC20-to-bank transfers.
+//   - PrecompileStaking: Implements the Staking precompile for delegations and rewards.
+//   - PrecompileIBCTransfer: Implements the IBC transfer precompile for ICS-20 transfers.
+//   - PrecompileBank: Implements the Bank precompile for native coin transfers and queries.
+//   - PrecompileTokenFactory: Implements the token factory precompile for creating and administering denoms.
+//   - PrecompileGov: Implements the governance precompile for proposals, deposits, and votes.
+//   - PrecompileP256Verify: Implements the RIP-7212 precompile for P-256 signature verification.
 //
 // The package also provides utility functions for working with precompiles, such
 // as "ABIMethodByID" and "OnRunStart" for common precompile execution setup.
@@ -22,10 +28,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	gethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
 	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
 )
 
@@ -47,24 +55,25 @@ func InitPrecompiles(
 		precompiles[addr] = pc
 	}
 
+	// RIP-7212: secp256r1 (P-256) signature verification
+	p256Verify := PrecompileP256Verify()
+	precompiles[p256Verify.Address()] = p256Verify
+
 	// Custom precompiles
 	for _, precompileSetupFn := range []func(k keepers.PublicKeepers) vm.PrecompiledContract{
 		PrecompileFunToken,
 		PrecompileWasm,
 		PrecompileOracle,
+		PrecompileStaking,
+		PrecompileIBCTransfer,
+		PrecompileBank,
+		PrecompileTokenFactory,
+		PrecompileGov,
 	} {
 		pc := precompileSetupFn(k)
 		precompiles[pc.Address()] = pc
 	}
 
-	// TODO: feat(evm): implement precompiled contracts for ibc transfer
-	// Check if there is sufficient demand for this.
-
-	// TODO: feat(evm): implement precompiled contracts for staking
-	// Note that liquid staked assets can be a useful alternative to adding a
-	// staking precompile.
-	// Check if there is sufficient demand for this.
-
 	return precompiles
 }
 
@@ -148,6 +157,10 @@ type OnRunStartResult struct {
 
 	StateDB *statedb.StateDB
 
+	// EVM is the instance of the EVM executing the precompile. Its tracer
+	// receives the child frames of the precompile call.
+	EVM *vm.EVM
+
 	PrecompileJournalEntry statedb.PrecompileCalled
 }
 
@@ -212,9 +225,130 @@ func OnRunStart(
 		CacheCtx: cacheCtx,
 		Method:   method,
 		StateDB:  stateDB,
+		EVM:      evm,
 	}, nil
 }
 
+// precompileWithABI is a precompile whose methods are defined by an ABI.
+type precompileWithABI interface {
+	vm.PrecompiledContract
+	ABI() *gethabi.ABI
+}
+
+// precompileMethod implements a method of a precompile, called by "caller".
+type precompileMethod func(start OnRunStartResult, caller gethcommon.Address) ([]byte, error)
+
+// runPrecompile implements "Run" for the precompiles built on [OnRunStart]. It
+// decodes the call, runs the implementation of the called method and charges
+// the gas used by the local gas meter. Mutating methods are rejected in a
+// read-only context, and query methods when they are sent funds. Errors of
+// the method are wrapped with [ErrMethodCalled].
+//
+// The NibiruBankKeeper needs to reference the current [vm.StateDB] before any
+// operation that has the potential to use Bank send methods. This guarantees
+// that [evmkeeper.Keeper.SetAccBalance] journal changes are recorded if wei
+// (NIBI) is transferred, so "bank" is pointed at the StateDB of the call
+// before the method runs. Precompiles that never send coins pass a nil "bank".
+func runPrecompile(
+	p precompileWithABI,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	readonly bool,
+	bank *evmkeeper.NibiruBankKeeper,
+	methods map[PrecompileMethod]precompileMethod,
+) (bz []byte, err error) {
+	defer func() {
+		err = ErrPrecompileRun(err, p)
+	}()
+	start, err := OnRunStart(evm, contract.Input, p.ABI(), contract.Gas)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gracefully handles "out of gas"
+	defer HandleOutOfGasPanic(&err)()
+
+	if bank != nil {
+		bank.StateDB = start.StateDB
+	}
+
+	method := start.Method
+	runMethod, ok := methods[PrecompileMethod(method.Name)]
+	if !ok {
+		// Note that this code path should be impossible to reach since
+		// "[decomposeInput]" parses methods directly from the ABI.
+		return nil, fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+	}
+	if isMutation[PrecompileMethod(method.Name)] {
+		err = assertNotReadonlyTx(readonly, method)
+	} else {
+		err = assertContractQuery(contract)
+	}
+	if err == nil {
+		bz, err = runMethod(start, contract.CallerAddress)
+	}
+	if err != nil {
+		return nil, ErrMethodCalled(method, err)
+	}
+
+	// Gas consumed by a local gas meter
+	contract.UseGas(start.CacheCtx.GasMeter().GasConsumed())
+	return bz, nil
+}
+
+// emitEventLog packs the ABI event named "eventName" and adds it to the
+// [statedb.StateDB] as an EVM log emitted by the precompile at "addr". Indexed
+// event inputs become topics and the remaining inputs are ABI-encoded as the
+// log data, so the log appears in the tx receipt, the block bloom, and the
+// filter APIs just like an "emit" from a Solidity contract. Because the log is
+// added through the StateDB journal, it is discarded if the precompile call is
+// reverted.
+//
+// The "args" must be given in the order of the event inputs.
+func emitEventLog(
+	start OnRunStartResult,
+	addr gethcommon.Address,
+	abi *gethabi.ABI,
+	eventName string,
+	args ...any,
+) error {
+	event, ok := abi.Events[eventName]
+	if !ok {
+		return fmt.Errorf("event \"%s\" not found in the ABI of precompile %s", eventName, addr.Hex())
+	}
+	if len(args) != len(event.Inputs) {
+		return fmt.Errorf(
+			"event \"%s\" expects %d args, got %d", eventName, len(event.Inputs), len(args),
+		)
+	}
+
+	topics := []gethcommon.Hash{event.ID}
+	var nonIndexedArgs []any
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexedArgs = append(nonIndexedArgs, args[i])
+			continue
+		}
+		inputTopics, err := gethabi.MakeTopics([]any{args[i]})
+		if err != nil {
+			return fmt.Errorf("failed to make topic for event \"%s\" input \"%s\": %w", eventName, input.Name, err)
+		}
+		topics = append(topics, inputTopics[0][0])
+	}
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexedArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to pack event \"%s\": %w", eventName, err)
+	}
+
+	start.StateDB.AddLog(&gethcore.Log{
+		Address:     addr,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(start.CacheCtx.BlockHeight()),
+	})
+	return nil
+}
+
 var isMutation map[PrecompileMethod]bool = map[PrecompileMethod]bool{
 	WasmMethod_execute:      true,
 	WasmMethod_instantiate:  true,
@@ -223,11 +357,47 @@ var isMutation map[PrecompileMethod]bool = map[PrecompileMethod]bool{
 	WasmMethod_queryRaw:     false,
 
 	FunTokenMethod_sendToBank:  true,
+	FunTokenMethod_sendToEvm:   true,
 	FunTokenMethod_balance:     false,
 	FunTokenMethod_bankBalance: false,
 	FunTokenMethod_whoAmI:      false,
 
-	OracleMethod_queryExchangeRate: false,
+	OracleMethod_queryExchangeRate:     false,
+	OracleMethod_queryExchangeRateTwap: false,
+	OracleMethod_queryExchangeRates:    false,
+	OracleMethod_queryActivePairs:      false,
+	OracleMethod_decimals:              false,
+	OracleMethod_latestRoundData:       false,
+
+	StakingMethod_delegate:        true,
+	StakingMethod_undelegate:      true,
+	StakingMethod_redelegate:      true,
+	StakingMethod_withdrawRewards: true,
+	StakingMethod_delegation:      false,
+	StakingMethod_validator:       false,
+
+	IBCTransferMethod_transfer: true,
+
+	BankMethod_send:          true,
+	BankMethod_balanceOf:     false,
+	BankMethod_allBalances:   false,
+	BankMethod_totalSupply:   false,
+	BankMethod_denomMetadata: false,
+
+	TokenFactoryMethod_createDenom:      true,
+	TokenFactoryMethod_mint:             true,
+	TokenFactoryMethod_burn:             true,
+	TokenFactoryMethod_changeAdmin:      true,
+	TokenFactoryMethod_setDenomMetadata: true,
+	TokenFactoryMethod_createFunToken:   true,
+	TokenFactoryMethod_denomAdmin:       false,
+
+	GovMethod_submitProposal: true,
+	GovMethod_deposit:        true,
+	GovMethod_vote:           true,
+	GovMethod_voteWeighted:   true,
+	GovMethod_proposal:       false,
+	GovMethod_tally:          false,
 }
 
 func HandleOutOfGasPanic(err *error) func() {
@@ -0,0 +1,442 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+var _ vm.PrecompiledContract = (*precompileStaking)(nil)
+
+// Precompile address for "IStaking.sol", the contract that enables EVM
+// accounts to delegate, undelegate, redelegate and claim staking rewards.
+var PrecompileAddr_Staking = gethcommon.HexToAddress("0x0000000000000000000000000000000000000803")
+
+func (p precompileStaking) Address() gethcommon.Address {
+	return PrecompileAddr_Staking
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileStaking) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileStaking) ABI() *gethabi.ABI {
+	return embeds.SmartContract_Staking.ABI
+}
+
+const (
+	StakingMethod_delegate        PrecompileMethod = "delegate"
+	StakingMethod_undelegate      PrecompileMethod = "undelegate"
+	StakingMethod_redelegate      PrecompileMethod = "redelegate"
+	StakingMethod_withdrawRewards PrecompileMethod = "withdrawRewards"
+	StakingMethod_delegation      PrecompileMethod = "delegation"
+	StakingMethod_validator       PrecompileMethod = "validator"
+)
+
+// Run runs the precompiled contract
+func (p precompileStaking) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	return runPrecompile(p, evm, contract, readonly, p.evmKeeper.Bank, map[PrecompileMethod]precompileMethod{
+		StakingMethod_delegate:        p.delegate,
+		StakingMethod_undelegate:      p.undelegate,
+		StakingMethod_redelegate:      p.redelegate,
+		StakingMethod_withdrawRewards: p.withdrawRewards,
+		StakingMethod_delegation:      p.delegation,
+		StakingMethod_validator:       p.validator,
+	})
+}
+
+func PrecompileStaking(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileStaking{
+		evmKeeper:     keepers.EvmKeeper,
+		stakingKeeper: keepers.StakingKeeper,
+		distrKeeper:   keepers.DistrKeeper,
+	}
+}
+
+type precompileStaking struct {
+	evmKeeper     *evmkeeper.Keeper
+	stakingKeeper *stakingkeeper.Keeper
+	distrKeeper   distrkeeper.Keeper
+}
+
+// delegate: Implements "IStaking.delegate"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function delegate(
+//	    string memory validatorAddr,
+//	    uint256 amount
+//	) external returns (bool success);
+//	```
+func (p precompileStaking) delegate(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	valAddr, amount, err := p.parseArgsValidatorAndAmount(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	_, err = stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Delegate(
+		sdk.WrapSDKContext(ctx),
+		&stakingtypes.MsgDelegate{
+			DelegatorAddress: eth.EthAddrToNibiruAddr(caller).String(),
+			ValidatorAddress: valAddr.String(),
+			Amount:           sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "Delegate", caller, valAddr.String(), amount.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// undelegate: Implements "IStaking.undelegate"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function undelegate(
+//	    string memory validatorAddr,
+//	    uint256 amount
+//	) external returns (int64 completionTime);
+//	```
+func (p precompileStaking) undelegate(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	valAddr, amount, err := p.parseArgsValidatorAndAmount(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	resp, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Undelegate(
+		sdk.WrapSDKContext(ctx),
+		&stakingtypes.MsgUndelegate{
+			DelegatorAddress: eth.EthAddrToNibiruAddr(caller).String(),
+			ValidatorAddress: valAddr.String(),
+			Amount:           sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "Undelegate",
+		caller, valAddr.String(), amount.BigInt(), resp.CompletionTime.Unix(),
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(resp.CompletionTime.Unix())
+}
+
+// redelegate: Implements "IStaking.redelegate"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function redelegate(
+//	    string memory srcValidatorAddr,
+//	    string memory dstValidatorAddr,
+//	    uint256 amount
+//	) external returns (int64 completionTime);
+//	```
+func (p precompileStaking) redelegate(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	srcValAddr, dstValAddr, amount, err := p.parseArgsRedelegate(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	resp, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).BeginRedelegate(
+		sdk.WrapSDKContext(ctx),
+		&stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    eth.EthAddrToNibiruAddr(caller).String(),
+			ValidatorSrcAddress: srcValAddr.String(),
+			ValidatorDstAddress: dstValAddr.String(),
+			Amount:              sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "Redelegate",
+		caller, srcValAddr.String(), dstValAddr.String(), amount.BigInt(), resp.CompletionTime.Unix(),
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(resp.CompletionTime.Unix())
+}
+
+// withdrawRewards: Implements "IStaking.withdrawRewards"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function withdrawRewards(
+//	    string memory validatorAddr
+//	) external returns (BankCoin[] memory rewards);
+//	```
+func (p precompileStaking) withdrawRewards(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	valAddr, err := parseArgValidatorAddr(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	resp, err := distrkeeper.NewMsgServerImpl(p.distrKeeper).WithdrawDelegatorReward(
+		sdk.WrapSDKContext(ctx),
+		&distrtypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: eth.EthAddrToNibiruAddr(caller).String(),
+			ValidatorAddress: valAddr.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := []struct {
+		Denom  string   `json:"denom"`
+		Amount *big.Int `json:"amount"`
+	}{}
+	for _, coin := range resp.Amount {
+		rewards = append(rewards, struct {
+			Denom  string   `json:"denom"`
+			Amount *big.Int `json:"amount"`
+		}{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		})
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "WithdrawRewards", caller, valAddr.String(), rewards,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(rewards)
+}
+
+// delegation: Implements "IStaking.delegation"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function delegation(
+//	    address delegator,
+//	    string memory validatorAddr
+//	) external view returns (Delegation memory info);
+//	```
+func (p precompileStaking) delegation(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+	delegator, ok := args[0].(gethcommon.Address)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("address delegator", args[0]))
+		return
+	}
+	valAddr, err := parseArgValidatorAddr(args[1])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	delAddr := eth.EthAddrToNibiruAddr(delegator)
+	validator, found := p.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+
+	shares, balance := math.LegacyZeroDec(), math.ZeroInt()
+	if del, found := p.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); found {
+		shares = del.Shares
+		balance = validator.TokensFromShares(del.Shares).TruncateInt()
+	}
+
+	return method.Outputs.Pack(struct {
+		Delegator string   `json:"delegator"`
+		Validator string   `json:"validator"`
+		Shares    *big.Int `json:"shares"`
+		Balance   *big.Int `json:"balance"`
+	}{
+		Delegator: delAddr.String(),
+		Validator: valAddr.String(),
+		Shares:    shares.BigInt(),
+		Balance:   balance.BigInt(),
+	})
+}
+
+// validator: Implements "IStaking.validator"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function validator(
+//	    string memory validatorAddr
+//	) external view returns (Validator memory info);
+//	```
+func (p precompileStaking) validator(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	valAddr, err := parseArgValidatorAddr(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	validator, found := p.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+
+	return method.Outputs.Pack(struct {
+		OperatorAddress string   `json:"operatorAddress"`
+		Moniker         string   `json:"moniker"`
+		Jailed          bool     `json:"jailed"`
+		Status          string   `json:"status"`
+		Tokens          *big.Int `json:"tokens"`
+		DelegatorShares *big.Int `json:"delegatorShares"`
+		CommissionRate  *big.Int `json:"commissionRate"`
+	}{
+		OperatorAddress: validator.OperatorAddress,
+		Moniker:         validator.Description.Moniker,
+		Jailed:          validator.Jailed,
+		Status:          validator.Status.String(),
+		Tokens:          validator.Tokens.BigInt(),
+		DelegatorShares: validator.DelegatorShares.BigInt(),
+		CommissionRate:  validator.Commission.Rate.BigInt(),
+	})
+}
+
+func (p precompileStaking) parseArgsValidatorAndAmount(args []any) (
+	valAddr sdk.ValAddress,
+	amount math.Int,
+	err error,
+) {
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	valAddr, err = parseArgValidatorAddr(args[argIdx])
+	if err != nil {
+		return
+	}
+
+	argIdx++
+	amount, err = parseArgStakingAmount(args[argIdx])
+	return
+}
+
+func (p precompileStaking) parseArgsRedelegate(args []any) (
+	srcValAddr sdk.ValAddress,
+	dstValAddr sdk.ValAddress,
+	amount math.Int,
+	err error,
+) {
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	srcValAddr, err = parseArgValidatorAddr(args[argIdx])
+	if err != nil {
+		return
+	}
+
+	argIdx++
+	dstValAddr, err = parseArgValidatorAddr(args[argIdx])
+	if err != nil {
+		return
+	}
+
+	argIdx++
+	amount, err = parseArgStakingAmount(args[argIdx])
+	return
+}
+
+// parseArgValidatorAddr parses a nibivaloper-prefixed Bech32 validator
+// operator address from a "string" ABI argument.
+func parseArgValidatorAddr(arg any) (valAddr sdk.ValAddress, err error) {
+	valAddrStr, ok := arg.(string)
+	if !ok {
+		return nil, ErrArgTypeValidation("string validatorAddr", arg)
+	}
+	valAddr, err = sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return nil, fmt.Errorf("\"validatorAddr\" is not a valid validator address (%s): %w", valAddrStr, err)
+	}
+	return valAddr, nil
+}
+
+// parseArgStakingAmount parses a positive amount of the bond denom from a
+// "uint256" ABI argument.
+func parseArgStakingAmount(arg any) (amount math.Int, err error) {
+	amountBig, ok := arg.(*big.Int)
+	if !ok {
+		return amount, ErrArgTypeValidation("uint256 amount", arg)
+	}
+	if amountBig == nil || amountBig.Sign() != 1 {
+		return amount, fmt.Errorf("amount must be positive")
+	}
+	return math.NewIntFromBigInt(amountBig), nil
+}
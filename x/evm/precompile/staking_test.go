@@ -0,0 +1,314 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+type StakingSuite struct {
+	suite.Suite
+}
+
+// TestStakingSuite: Runs all the tests in the suite.
+func TestStakingSuite(t *testing.T) {
+	suite.Run(t, new(StakingSuite))
+}
+
+func (s *StakingSuite) TestFailToPackABI() {
+	testcases := []struct {
+		name       string
+		methodName string
+		callArgs   []any
+		wantError  string
+	}{
+		{
+			name:       "wrong amount of call args",
+			methodName: string(precompile.StakingMethod_delegate),
+			callArgs:   []any{"nonsense", "args here", "to see if"},
+			wantError:  "argument count mismatch: got 3 for 2",
+		},
+		{
+			name:       "wrong type for amount",
+			methodName: string(precompile.StakingMethod_delegate),
+			callArgs:   []any{"nibivaloper1", "foo"},
+			wantError:  "abi: cannot use string as type ptr as argument",
+		},
+		{
+			name:       "wrong type for delegator",
+			methodName: string(precompile.StakingMethod_delegation),
+			callArgs:   []any{"foo", "nibivaloper1"},
+			wantError:  "abi: cannot use string as type array as argument",
+		},
+		{
+			name:       "invalid method name",
+			methodName: "foo",
+			callArgs:   []any{"nibivaloper1", big.NewInt(1)},
+			wantError:  "method 'foo' not found",
+		},
+	}
+
+	abi := embeds.SmartContract_Staking.ABI
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			input, err := abi.Pack(tc.methodName, tc.callArgs...)
+			s.ErrorContains(err, tc.wantError)
+			s.Nil(input)
+		})
+	}
+}
+
+func (s *StakingSuite) TestHappyPath() {
+	deps := evmtest.NewTestDeps()
+	validator := deps.App.StakingKeeper.GetValidators(deps.Ctx, 1)[0]
+	valAddr := validator.GetOperator().String()
+	bondDenom := deps.App.StakingKeeper.BondDenom(deps.Ctx)
+
+	s.T().Log("Fund sender with the bond denom")
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(69_420))),
+	))
+	// Leftover gas fee is refunded within EthereumTx from the FeeCollector
+	// so, the module must have some coins
+	s.Require().NoError(testapp.FundModuleAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(1_000_000))),
+	))
+
+	s.Run("IStaking.validator", func() {
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx,
+			embeds.SmartContract_Staking.ABI,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Staking,
+			false,
+			keeper.Erc20GasLimitExecute,
+			string(precompile.StakingMethod_validator),
+			valAddr,
+		)
+		s.Require().NoError(err, evmResp)
+
+		out := new(StakingValidatorReturn)
+		s.Require().NoError(embeds.SmartContract_Staking.ABI.UnpackIntoInterface(
+			out, string(precompile.StakingMethod_validator), evmResp.Ret,
+		))
+		s.Equal(valAddr, out.Info.OperatorAddress)
+		s.Equal(stakingtypes.Bonded.String(), out.Info.Status)
+		s.False(out.Info.Jailed)
+		s.Equal(validator.Tokens.String(), out.Info.Tokens.String())
+	})
+
+	s.T().Log("Delegate using the precompile")
+	input, err := embeds.SmartContract_Staking.ABI.Pack(
+		string(precompile.StakingMethod_delegate), valAddr, big.NewInt(69_000),
+	)
+	s.Require().NoError(err)
+	deps.ResetGasMeter()
+	_, ethTxResp, err := evmtest.CallContractTx(
+		&deps, precompile.PrecompileAddr_Staking, input, deps.Sender,
+	)
+	s.Require().NoError(err)
+	s.Require().Empty(ethTxResp.VmError)
+
+	s.Equal("420", deps.App.BankKeeper.GetBalance(
+		deps.Ctx, deps.Sender.NibiruAddr, bondDenom).Amount.String(),
+	)
+	delegation, found := deps.App.StakingKeeper.GetDelegation(
+		deps.Ctx, deps.Sender.NibiruAddr, validator.GetOperator(),
+	)
+	s.Require().True(found)
+	s.Equal(deps.Sender.NibiruAddr.String(), delegation.DelegatorAddress)
+
+	s.Run("IStaking.delegation", func() {
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx,
+			embeds.SmartContract_Staking.ABI,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Staking,
+			false,
+			keeper.Erc20GasLimitExecute,
+			string(precompile.StakingMethod_delegation),
+			deps.Sender.EthAddr,
+			valAddr,
+		)
+		s.Require().NoError(err, evmResp)
+
+		out := new(StakingDelegationReturn)
+		s.Require().NoError(embeds.SmartContract_Staking.ABI.UnpackIntoInterface(
+			out, string(precompile.StakingMethod_delegation), evmResp.Ret,
+		))
+		s.Equal(deps.Sender.NibiruAddr.String(), out.Info.Delegator)
+		s.Equal(valAddr, out.Info.Validator)
+		s.Equal("69000", out.Info.Balance.String())
+		s.Equal(delegation.Shares.BigInt().String(), out.Info.Shares.String())
+	})
+
+	s.T().Log("Withdraw rewards using the precompile")
+	input, err = embeds.SmartContract_Staking.ABI.Pack(
+		string(precompile.StakingMethod_withdrawRewards), valAddr,
+	)
+	s.Require().NoError(err)
+	deps.ResetGasMeter()
+	_, ethTxResp, err = evmtest.CallContractTx(
+		&deps, precompile.PrecompileAddr_Staking, input, deps.Sender,
+	)
+	s.Require().NoError(err)
+	s.Require().Empty(ethTxResp.VmError)
+
+	s.T().Log("Redelegating to the same validator fails")
+	input, err = embeds.SmartContract_Staking.ABI.Pack(
+		string(precompile.StakingMethod_redelegate), valAddr, valAddr, big.NewInt(1),
+	)
+	s.Require().NoError(err)
+	deps.ResetGasMeter()
+	_, _, err = evmtest.CallContractTx(
+		&deps, precompile.PrecompileAddr_Staking, input, deps.Sender,
+	)
+	s.Require().ErrorContains(err, "cannot redelegate to the same validator")
+
+	s.T().Log("Undelegate using the precompile")
+	input, err = embeds.SmartContract_Staking.ABI.Pack(
+		string(precompile.StakingMethod_undelegate), valAddr, big.NewInt(9_000),
+	)
+	s.Require().NoError(err)
+	deps.ResetGasMeter()
+	_, ethTxResp, err = evmtest.CallContractTx(
+		&deps, precompile.PrecompileAddr_Staking, input, deps.Sender,
+	)
+	s.Require().NoError(err)
+	s.Require().Empty(ethTxResp.VmError)
+
+	var completionTime int64
+	s.Require().NoError(embeds.SmartContract_Staking.ABI.UnpackIntoInterface(
+		&completionTime, string(precompile.StakingMethod_undelegate), ethTxResp.Ret,
+	))
+	unbondingTime := deps.App.StakingKeeper.UnbondingTime(deps.Ctx)
+	s.Equal(deps.Ctx.BlockTime().Add(unbondingTime).Unix(), completionTime)
+
+	ubd, found := deps.App.StakingKeeper.GetUnbondingDelegation(
+		deps.Ctx, deps.Sender.NibiruAddr, validator.GetOperator(),
+	)
+	s.Require().True(found)
+	s.Require().Len(ubd.Entries, 1)
+	s.Equal("9000", ubd.Entries[0].Balance.String())
+}
+
+func (s *StakingSuite) TestSadPaths() {
+	deps := evmtest.NewTestDeps()
+	validator := deps.App.StakingKeeper.GetValidators(deps.Ctx, 1)[0]
+	valAddr := validator.GetOperator().String()
+
+	for _, tc := range []struct {
+		name      string
+		method    precompile.PrecompileMethod
+		args      []any
+		wantError string
+	}{
+		{
+			name:      "delegate: invalid validator address",
+			method:    precompile.StakingMethod_delegate,
+			args:      []any{"not_a_valoper", big.NewInt(1)},
+			wantError: "is not a valid validator address",
+		},
+		{
+			name:      "delegate: amount must be positive",
+			method:    precompile.StakingMethod_delegate,
+			args:      []any{valAddr, big.NewInt(0)},
+			wantError: "amount must be positive",
+		},
+		{
+			name:      "delegate: insufficient funds",
+			method:    precompile.StakingMethod_delegate,
+			args:      []any{valAddr, big.NewInt(1)},
+			wantError: "insufficient funds",
+		},
+		{
+			name:      "undelegate: no delegation",
+			method:    precompile.StakingMethod_undelegate,
+			args:      []any{valAddr, big.NewInt(1)},
+			wantError: "no delegation for (address, validator) tuple",
+		},
+	} {
+		s.Run(tc.name, func() {
+			input, err := embeds.SmartContract_Staking.ABI.Pack(string(tc.method), tc.args...)
+			s.Require().NoError(err)
+			deps.ResetGasMeter()
+			_, _, err = evmtest.CallContractTx(
+				&deps, precompile.PrecompileAddr_Staking, input, deps.Sender,
+			)
+			s.Require().ErrorContains(err, tc.wantError)
+		})
+	}
+
+	s.T().Log("Querying an invalid validator address fails")
+	_, err := deps.EvmKeeper.CallContract(
+		deps.Ctx,
+		embeds.SmartContract_Staking.ABI,
+		deps.Sender.EthAddr,
+		&precompile.PrecompileAddr_Staking,
+		false,
+		keeper.Erc20GasLimitExecute,
+		string(precompile.StakingMethod_validator),
+		"not_a_valoper",
+	)
+	s.Require().ErrorContains(err, "is not a valid validator address")
+
+	s.T().Log("Querying a delegation of an unknown validator fails")
+	_, err = deps.EvmKeeper.CallContract(
+		deps.Ctx,
+		embeds.SmartContract_Staking.ABI,
+		deps.Sender.EthAddr,
+		&precompile.PrecompileAddr_Staking,
+		false,
+		keeper.Erc20GasLimitExecute,
+		string(precompile.StakingMethod_delegation),
+		evm.EVM_MODULE_ADDRESS,
+		sdk.ValAddress(gethcommon.Address{}.Bytes()).String(),
+	)
+	s.Require().ErrorContains(err, "validator does not exist")
+}
+
+// StakingValidatorReturn holds the return values from the "IStaking.validator"
+// method. The return bytes from successful calls of that method can be ABI
+// unpacked into this struct.
+type StakingValidatorReturn struct {
+	Info struct {
+		OperatorAddress string   `abi:"operatorAddress"`
+		Moniker         string   `abi:"moniker"`
+		Jailed          bool     `abi:"jailed"`
+		Status          string   `abi:"status"`
+		Tokens          *big.Int `abi:"tokens"`
+		DelegatorShares *big.Int `abi:"delegatorShares"`
+		CommissionRate  *big.Int `abi:"commissionRate"`
+	} `abi:"info"`
+}
+
+// StakingDelegationReturn holds the return values from the
+// "IStaking.delegation" method. The return bytes from successful calls of that
+// method can be ABI unpacked into this struct.
+type StakingDelegationReturn struct {
+	Info struct {
+		Delegator string   `abi:"delegator"`
+		Validator string   `abi:"validator"`
+		Shares    *big.Int `abi:"shares"`
+		Balance   *big.Int `abi:"balance"`
+	} `abi:"info"`
+}
@@ -11,11 +11,15 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasm "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	serverconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
@@ -371,3 +375,30 @@ func IncrementWasmCounterWithExecuteMultiViaVMCall(
 	)
 	return err
 }
+
+// RequirePrecompileEventLog finds the log of the ABI event "eventName" emitted
+// by the precompile at "precompileAddr" in the tx "logs". It returns the log
+// along with its unpacked non-indexed arguments and fails the test if no such
+// log exists.
+func RequirePrecompileEventLog(
+	s *suite.Suite,
+	logs []*evm.Log,
+	precompileAddr gethcommon.Address,
+	abi *gethabi.ABI,
+	eventName string,
+) (eventLog *gethcore.Log, data []any) {
+	event, ok := abi.Events[eventName]
+	s.Require().Truef(ok, "event %s not found in the ABI", eventName)
+	for _, log := range logs {
+		ethLog := log.ToEthereum()
+		if ethLog.Address != precompileAddr ||
+			len(ethLog.Topics) == 0 || ethLog.Topics[0] != event.ID {
+			continue
+		}
+		data, err := event.Inputs.NonIndexed().Unpack(ethLog.Data)
+		s.Require().NoError(err)
+		return ethLog, data
+	}
+	s.Require().Failf("missing event log", "no %s log from precompile %s", eventName, precompileAddr.Hex())
+	return nil, nil
+}
@@ -0,0 +1,509 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	tfkeeper "github.com/NibiruChain/nibiru/v2/x/tokenfactory/keeper"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
+)
+
+var _ vm.PrecompiledContract = (*precompileTokenFactory)(nil)
+
+// Precompile address for "ITokenFactory.sol", the contract that enables EVM
+// accounts to create token factory denoms and act as their admin.
+var PrecompileAddr_TokenFactory = gethcommon.HexToAddress("0x0000000000000000000000000000000000000806")
+
+func (p precompileTokenFactory) Address() gethcommon.Address {
+	return PrecompileAddr_TokenFactory
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileTokenFactory) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileTokenFactory) ABI() *gethabi.ABI {
+	return embeds.SmartContract_TokenFactory.ABI
+}
+
+const (
+	TokenFactoryMethod_createDenom      PrecompileMethod = "createDenom"
+	TokenFactoryMethod_mint             PrecompileMethod = "mint"
+	TokenFactoryMethod_burn             PrecompileMethod = "burn"
+	TokenFactoryMethod_changeAdmin      PrecompileMethod = "changeAdmin"
+	TokenFactoryMethod_setDenomMetadata PrecompileMethod = "setDenomMetadata"
+	TokenFactoryMethod_createFunToken   PrecompileMethod = "createFunToken"
+	TokenFactoryMethod_denomAdmin       PrecompileMethod = "denomAdmin"
+)
+
+// Run runs the precompiled contract
+func (p precompileTokenFactory) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	return runPrecompile(p, evm, contract, readonly, p.evmKeeper.Bank, map[PrecompileMethod]precompileMethod{
+		TokenFactoryMethod_createDenom:      p.createDenom,
+		TokenFactoryMethod_mint:             p.mint,
+		TokenFactoryMethod_burn:             p.burn,
+		TokenFactoryMethod_changeAdmin:      p.changeAdmin,
+		TokenFactoryMethod_setDenomMetadata: p.setDenomMetadata,
+		TokenFactoryMethod_createFunToken:   p.createFunToken,
+		TokenFactoryMethod_denomAdmin:       p.denomAdmin,
+	})
+}
+
+func PrecompileTokenFactory(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileTokenFactory{
+		evmKeeper:          keepers.EvmKeeper,
+		tokenFactoryKeeper: keepers.TokenFactoryKeeper,
+	}
+}
+
+type precompileTokenFactory struct {
+	evmKeeper          *evmkeeper.Keeper
+	tokenFactoryKeeper tfkeeper.Keeper
+}
+
+// createDenom: Implements "ITokenFactory.createDenom"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function createDenom(
+//	    string memory subdenom
+//	) external returns (string memory denom);
+//	```
+//
+// The caller becomes both the creator and the admin of the new denom, which
+// has the form "tf/{caller-bech32}/{subdenom}".
+func (p precompileTokenFactory) createDenom(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	subdenom, ok := args[0].(string)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("string subdenom", args[0]))
+		return
+	}
+
+	resp, err := p.tokenFactoryKeeper.CreateDenom(
+		sdk.WrapSDKContext(ctx),
+		&tftypes.MsgCreateDenom{
+			Sender:   eth.EthAddrToNibiruAddr(caller).String(),
+			Subdenom: subdenom,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "CreateDenom", caller, resp.NewTokenDenom,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(resp.NewTokenDenom)
+}
+
+// mint: Implements "ITokenFactory.mint"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function mint(
+//	    string memory denom,
+//	    uint256 amount,
+//	    string memory mintTo
+//	) external returns (bool success);
+//	```
+func (p precompileTokenFactory) mint(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	coin, mintTo, err := p.parseArgsSupplyChange(args, "mintTo")
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	_, err = p.tokenFactoryKeeper.Mint(
+		sdk.WrapSDKContext(ctx),
+		&tftypes.MsgMint{
+			Sender: eth.EthAddrToNibiruAddr(caller).String(),
+			Coin:   coin,
+			MintTo: mintTo,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if mintTo == "" {
+		mintTo = eth.EthAddrToNibiruAddr(caller).String()
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "Mint", caller, coin.Denom, coin.Amount.BigInt(), mintTo,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// burn: Implements "ITokenFactory.burn"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function burn(
+//	    string memory denom,
+//	    uint256 amount,
+//	    string memory burnFrom
+//	) external returns (bool success);
+//	```
+func (p precompileTokenFactory) burn(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	coin, burnFrom, err := p.parseArgsSupplyChange(args, "burnFrom")
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	_, err = p.tokenFactoryKeeper.Burn(
+		sdk.WrapSDKContext(ctx),
+		&tftypes.MsgBurn{
+			Sender:   eth.EthAddrToNibiruAddr(caller).String(),
+			Coin:     coin,
+			BurnFrom: burnFrom,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if burnFrom == "" {
+		burnFrom = eth.EthAddrToNibiruAddr(caller).String()
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "Burn", caller, coin.Denom, coin.Amount.BigInt(), burnFrom,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// parseArgsSupplyChange parses the arguments shared by "ITokenFactory.mint"
+// and "ITokenFactory.burn". The returned address is empty if the caller
+// passed an empty string, in which case the token factory defaults to the
+// sender.
+func (p precompileTokenFactory) parseArgsSupplyChange(
+	args []any, addrArgName string,
+) (coin sdk.Coin, addr string, err error) {
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	denom, ok := args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string denom", args[argIdx])
+		return
+	}
+
+	argIdx++
+	amount, ok := args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[argIdx])
+		return
+	}
+	if amount == nil || amount.Sign() != 1 {
+		err = fmt.Errorf("amount must be positive")
+		return
+	}
+
+	argIdx++
+	addrArg, ok := args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string "+addrArgName, args[argIdx])
+		return
+	}
+	if strings.TrimSpace(addrArg) != "" {
+		nibiAddr, e := parseArgTokenFactoryAddr(addrArg, addrArgName)
+		if e != nil {
+			err = e
+			return
+		}
+		addr = nibiAddr.String()
+	}
+
+	return sdk.NewCoin(denom, math.NewIntFromBigInt(amount)), addr, nil
+}
+
+// changeAdmin: Implements "ITokenFactory.changeAdmin"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function changeAdmin(
+//	    string memory denom,
+//	    string memory newAdmin
+//	) external returns (bool success);
+//	```
+func (p precompileTokenFactory) changeAdmin(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+	denom, ok := args[0].(string)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("string denom", args[0]))
+		return
+	}
+	newAdminArg, ok := args[1].(string)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("string newAdmin", args[1]))
+		return
+	}
+	newAdmin, err := parseArgTokenFactoryAddr(newAdminArg, "newAdmin")
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	_, err = p.tokenFactoryKeeper.ChangeAdmin(
+		sdk.WrapSDKContext(ctx),
+		&tftypes.MsgChangeAdmin{
+			Sender:   eth.EthAddrToNibiruAddr(caller).String(),
+			Denom:    denom,
+			NewAdmin: newAdmin.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "ChangeAdmin", caller, denom, newAdmin.String(),
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// setDenomMetadata: Implements "ITokenFactory.setDenomMetadata"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function setDenomMetadata(
+//	    DenomMetadata memory metadata
+//	) external returns (bool success);
+//	```
+func (p precompileTokenFactory) setDenomMetadata(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	metadata, err := p.parseArgsSetDenomMetadata(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	_, err = p.tokenFactoryKeeper.SetDenomMetadata(
+		sdk.WrapSDKContext(ctx),
+		&tftypes.MsgSetDenomMetadata{
+			Sender:   eth.EthAddrToNibiruAddr(caller).String(),
+			Metadata: metadata,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "SetDenomMetadata", caller, metadata.Base,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+func (p precompileTokenFactory) parseArgsSetDenomMetadata(args []any) (
+	metadata banktypes.Metadata, err error,
+) {
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+
+	arg, ok := args[0].(struct {
+		Description string `json:"description"`
+		DenomUnits  []struct {
+			Denom    string   `json:"denom"`
+			Exponent uint32   `json:"exponent"`
+			Aliases  []string `json:"aliases"`
+		} `json:"denomUnits"`
+		Base    string `json:"base"`
+		Display string `json:"display"`
+		Name    string `json:"name"`
+		Symbol  string `json:"symbol"`
+	})
+	if !ok {
+		err = ErrArgTypeValidation("DenomMetadata metadata", args[0])
+		return
+	}
+
+	denomUnits := make([]*banktypes.DenomUnit, len(arg.DenomUnits))
+	for idx, unit := range arg.DenomUnits {
+		var aliases []string
+		if len(unit.Aliases) > 0 {
+			aliases = unit.Aliases
+		}
+		denomUnits[idx] = &banktypes.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  aliases,
+		}
+	}
+	return banktypes.Metadata{
+		Description: arg.Description,
+		DenomUnits:  denomUnits,
+		Base:        arg.Base,
+		Display:     arg.Display,
+		Name:        arg.Name,
+		Symbol:      arg.Symbol,
+	}, nil
+}
+
+// createFunToken: Implements "ITokenFactory.createFunToken"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function createFunToken(
+//	    string memory denom
+//	) external returns (address erc20);
+//	```
+//
+// Only the admin of the denom can register its FunToken mapping, which makes
+// it possible to launch a token entirely from the EVM. The ERC20 contract is
+// deployed from the bank metadata of the denom, so "setDenomMetadata" should
+// be called first. The "create_funtoken_fee" is paid by the caller.
+func (p precompileTokenFactory) createFunToken(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	denom, ok := args[0].(string)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("string denom", args[0]))
+		return
+	}
+
+	sender := eth.EthAddrToNibiruAddr(caller).String()
+	admin, err := p.tokenFactoryKeeper.Store.GetAdmin(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("denom \"%s\" is not a token factory denom: %w", denom, err)
+	}
+	if admin != sender {
+		return nil, tftypes.ErrUnauthorized.Wrapf(
+			"sender (%s), admin (%s)", sender, admin,
+		)
+	}
+
+	resp, err := p.evmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(ctx),
+		&evm.MsgCreateFunToken{
+			FromBankDenom: denom,
+			Sender:        sender,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	erc20 := resp.FuntokenMapping.Erc20Addr.Address
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "CreateFunToken", caller, denom, erc20,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(erc20)
+}
+
+// denomAdmin: Implements "ITokenFactory.denomAdmin"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function denomAdmin(
+//	    string memory denom
+//	) external view returns (string memory admin);
+//	```
+func (p precompileTokenFactory) denomAdmin(
+	start OnRunStartResult,
+	_ gethcommon.Address,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = e
+		return
+	}
+	denom, ok := args[0].(string)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("string denom", args[0]))
+		return
+	}
+
+	authData, err := p.tokenFactoryKeeper.Store.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(authData.Admin)
+}
+
+// parseArgTokenFactoryAddr parses the Nibiru address of an account from a hex
+// (0x) or Bech32 (nibi) encoded "string" ABI argument.
+func parseArgTokenFactoryAddr(arg string, argName string) (addr sdk.AccAddress, err error) {
+	req := &evm.QueryEthAccountRequest{Address: arg}
+	isBech32, err := req.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("\"%s\" is not a valid address (%s): %w", argName, arg, err)
+	}
+	if isBech32 {
+		return sdk.MustAccAddressFromBech32(req.Address), nil
+	}
+	return eth.EthAddrToNibiruAddr(gethcommon.HexToAddress(req.Address)), nil
+}
@@ -0,0 +1,324 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
+)
+
+type TokenFactorySuite struct {
+	suite.Suite
+}
+
+// TestTokenFactorySuite: Runs all the tests in the suite.
+func TestTokenFactorySuite(t *testing.T) {
+	suite.Run(t, new(TokenFactorySuite))
+}
+
+func (s *TokenFactorySuite) TestFailToPackABI() {
+	testcases := []struct {
+		name       string
+		methodName string
+		callArgs   []any
+		wantError  string
+	}{
+		{
+			name:       "wrong amount of call args",
+			methodName: string(precompile.TokenFactoryMethod_mint),
+			callArgs:   []any{"tf/nibi1/foo", big.NewInt(1)},
+			wantError:  "argument count mismatch: got 2 for 3",
+		},
+		{
+			name:       "wrong type for amount",
+			methodName: string(precompile.TokenFactoryMethod_burn),
+			callArgs:   []any{"tf/nibi1/foo", "1", ""},
+			wantError:  "abi: cannot use string as type ptr as argument",
+		},
+		{
+			name:       "wrong type for metadata",
+			methodName: string(precompile.TokenFactoryMethod_setDenomMetadata),
+			callArgs:   []any{"tf/nibi1/foo"},
+			wantError:  "abi: cannot use string as type struct as argument",
+		},
+		{
+			name:       "invalid method name",
+			methodName: "foo",
+			callArgs:   []any{"foo"},
+			wantError:  "method 'foo' not found",
+		},
+	}
+
+	abi := embeds.SmartContract_TokenFactory.ABI
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			input, err := abi.Pack(tc.methodName, tc.callArgs...)
+			s.ErrorContains(err, tc.wantError)
+			s.Nil(input)
+		})
+	}
+}
+
+// TokenFactoryDenomMetadata mirrors the "ITokenFactory.DenomMetadata" struct
+// for ABI packing.
+type TokenFactoryDenomMetadata struct {
+	Description string                  `abi:"description"`
+	DenomUnits  []TokenFactoryDenomUnit `abi:"denomUnits"`
+	Base        string                  `abi:"base"`
+	Display     string                  `abi:"display"`
+	Name        string                  `abi:"name"`
+	Symbol      string                  `abi:"symbol"`
+}
+
+// TokenFactoryDenomUnit mirrors the "ITokenFactory.DenomUnit" struct for ABI
+// packing.
+type TokenFactoryDenomUnit struct {
+	Denom    string   `abi:"denom"`
+	Exponent uint32   `abi:"exponent"`
+	Aliases  []string `abi:"aliases"`
+}
+
+func (s *TokenFactorySuite) TestHappyPath() {
+	deps := evmtest.NewTestDeps()
+	recipient := evmtest.NewEthPrivAcc()
+	newAdmin := testutil.AccAddress()
+	wantDenom := tftypes.TFDenom{
+		Creator:  deps.Sender.NibiruAddr.String(),
+		Subdenom: "launch",
+	}.Denom().String()
+
+	s.T().Log("Fund sender to pay the FunToken creation fee")
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+	// Leftover gas fee is refunded within EthereumTx from the FeeCollector
+	// so, the module must have some coins
+	s.Require().NoError(testapp.FundModuleAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(1_000_000))),
+	))
+
+	callTokenFactory := func(method precompile.PrecompileMethod, args ...any) []byte {
+		input, err := embeds.SmartContract_TokenFactory.ABI.Pack(string(method), args...)
+		s.Require().NoError(err)
+		deps.ResetGasMeter()
+		_, ethTxResp, err := evmtest.CallContractTx(
+			&deps, precompile.PrecompileAddr_TokenFactory, input, deps.Sender,
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(ethTxResp.VmError)
+		return ethTxResp.Ret
+	}
+	queryDenomAdmin := func() string {
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx,
+			embeds.SmartContract_TokenFactory.ABI,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_TokenFactory,
+			false,
+			keeper.Erc20GasLimitExecute,
+			string(precompile.TokenFactoryMethod_denomAdmin),
+			wantDenom,
+		)
+		s.Require().NoError(err, evmResp)
+		var admin string
+		s.Require().NoError(embeds.SmartContract_TokenFactory.ABI.UnpackIntoInterface(
+			&admin, string(precompile.TokenFactoryMethod_denomAdmin), evmResp.Ret,
+		))
+		return admin
+	}
+
+	s.Run("ITokenFactory.createDenom", func() {
+		var denom string
+		s.Require().NoError(embeds.SmartContract_TokenFactory.ABI.UnpackIntoInterface(
+			&denom, string(precompile.TokenFactoryMethod_createDenom),
+			callTokenFactory(precompile.TokenFactoryMethod_createDenom, "launch"),
+		))
+		s.Equal(wantDenom, denom)
+		s.Equal(deps.Sender.NibiruAddr.String(), queryDenomAdmin())
+	})
+
+	s.Run("ITokenFactory.setDenomMetadata", func() {
+		callTokenFactory(
+			precompile.TokenFactoryMethod_setDenomMetadata,
+			TokenFactoryDenomMetadata{
+				Description: "A token launched from the EVM",
+				DenomUnits: []TokenFactoryDenomUnit{
+					{Denom: wantDenom, Exponent: 0, Aliases: []string{}},
+					{Denom: "LAUNCH", Exponent: 6, Aliases: []string{"launch"}},
+				},
+				Base:    wantDenom,
+				Display: "LAUNCH",
+				Name:    "Launch",
+				Symbol:  "LAUNCH",
+			},
+		)
+		md, found := deps.App.BankKeeper.GetDenomMetaData(deps.Ctx, wantDenom)
+		s.Require().True(found)
+		s.Equal("Launch", md.Name)
+		s.Equal("LAUNCH", md.Display)
+		s.Require().Len(md.DenomUnits, 2)
+		s.Equal(uint32(6), md.DenomUnits[1].Exponent)
+	})
+
+	var erc20 gethcommon.Address
+	s.Run("ITokenFactory.createFunToken", func() {
+		s.Require().NoError(embeds.SmartContract_TokenFactory.ABI.UnpackIntoInterface(
+			&erc20, string(precompile.TokenFactoryMethod_createFunToken),
+			callTokenFactory(precompile.TokenFactoryMethod_createFunToken, wantDenom),
+		))
+		funtokens := deps.EvmKeeper.FunTokens.Collect(
+			deps.Ctx, deps.EvmKeeper.FunTokens.Indexes.BankDenom.ExactMatch(deps.Ctx, wantDenom),
+		)
+		s.Require().Len(funtokens, 1)
+		s.Equal(erc20, funtokens[0].Erc20Addr.Address)
+		s.True(funtokens[0].IsMadeFromCoin)
+		s.True(deps.App.BankKeeper.GetBalance(
+			deps.Ctx, deps.Sender.NibiruAddr, evm.EVMBankDenom).IsZero(),
+			"the FunToken creation fee must be paid by the caller",
+		)
+
+		info, err := deps.EvmKeeper.FindERC20Metadata(deps.Ctx, erc20)
+		s.Require().NoError(err)
+		s.Equal("Launch", info.Name)
+		s.Equal(uint8(6), info.Decimals)
+	})
+
+	s.Run("ITokenFactory.mint", func() {
+		callTokenFactory(
+			precompile.TokenFactoryMethod_mint, wantDenom, big.NewInt(1_000), "",
+		)
+		callTokenFactory(
+			precompile.TokenFactoryMethod_mint, wantDenom, big.NewInt(500), recipient.EthAddr.Hex(),
+		)
+		s.Equal("1000", deps.App.BankKeeper.GetBalance(
+			deps.Ctx, deps.Sender.NibiruAddr, wantDenom).Amount.String(),
+		)
+		s.Equal("500", deps.App.BankKeeper.GetBalance(
+			deps.Ctx, recipient.NibiruAddr, wantDenom).Amount.String(),
+		)
+	})
+
+	s.Run("ITokenFactory.burn", func() {
+		callTokenFactory(
+			precompile.TokenFactoryMethod_burn, wantDenom, big.NewInt(400), "",
+		)
+		s.Equal("600", deps.App.BankKeeper.GetBalance(
+			deps.Ctx, deps.Sender.NibiruAddr, wantDenom).Amount.String(),
+		)
+		s.Equal("1100", deps.App.BankKeeper.GetSupply(deps.Ctx, wantDenom).Amount.String())
+	})
+
+	s.Run("ITokenFactory.changeAdmin", func() {
+		callTokenFactory(
+			precompile.TokenFactoryMethod_changeAdmin, wantDenom, newAdmin.String(),
+		)
+		s.Equal(newAdmin.String(), queryDenomAdmin())
+
+		s.T().Log("The former admin can no longer mint")
+		input, err := embeds.SmartContract_TokenFactory.ABI.Pack(
+			string(precompile.TokenFactoryMethod_mint), wantDenom, big.NewInt(1), "",
+		)
+		s.Require().NoError(err)
+		deps.ResetGasMeter()
+		_, _, err = evmtest.CallContractTx(
+			&deps, precompile.PrecompileAddr_TokenFactory, input, deps.Sender,
+		)
+		s.Require().ErrorContains(err, "sender must be admin")
+	})
+}
+
+func (s *TokenFactorySuite) TestSadPaths() {
+	deps := evmtest.NewTestDeps()
+	otherDenom := tftypes.TFDenom{
+		Creator:  testutil.AccAddress().String(),
+		Subdenom: "other",
+	}
+	s.Require().NoError(deps.App.TokenFactoryKeeper.Store.InsertDenom(deps.Ctx, otherDenom))
+
+	for _, tc := range []struct {
+		name      string
+		method    precompile.PrecompileMethod
+		args      []any
+		wantError string
+	}{
+		{
+			name:      "empty subdenom",
+			method:    precompile.TokenFactoryMethod_createDenom,
+			args:      []any{""},
+			wantError: "empty subdenom",
+		},
+		{
+			name:      "mint amount must be positive",
+			method:    precompile.TokenFactoryMethod_mint,
+			args:      []any{otherDenom.Denom().String(), big.NewInt(0), ""},
+			wantError: "amount must be positive",
+		},
+		{
+			name:      "mint to invalid address",
+			method:    precompile.TokenFactoryMethod_mint,
+			args:      []any{otherDenom.Denom().String(), big.NewInt(1), "not_an_address"},
+			wantError: "\"mintTo\" is not a valid address",
+		},
+		{
+			name:      "mint denom administered by another account",
+			method:    precompile.TokenFactoryMethod_mint,
+			args:      []any{otherDenom.Denom().String(), big.NewInt(1), ""},
+			wantError: "sender must be admin",
+		},
+		{
+			name:      "burn denom administered by another account",
+			method:    precompile.TokenFactoryMethod_burn,
+			args:      []any{otherDenom.Denom().String(), big.NewInt(1), ""},
+			wantError: "sender must be admin",
+		},
+		{
+			name:      "change admin of denom administered by another account",
+			method:    precompile.TokenFactoryMethod_changeAdmin,
+			args:      []any{otherDenom.Denom().String(), deps.Sender.NibiruAddr.String()},
+			wantError: "only the current admin can set a new admin",
+		},
+		{
+			name:      "create FunToken for denom administered by another account",
+			method:    precompile.TokenFactoryMethod_createFunToken,
+			args:      []any{otherDenom.Denom().String()},
+			wantError: "sender must be admin",
+		},
+		{
+			name:      "create FunToken for a denom outside the token factory",
+			method:    precompile.TokenFactoryMethod_createFunToken,
+			args:      []any{evm.EVMBankDenom},
+			wantError: "is not a token factory denom",
+		},
+	} {
+		s.Run(tc.name, func() {
+			input, err := embeds.SmartContract_TokenFactory.ABI.Pack(
+				string(tc.method), tc.args...,
+			)
+			s.Require().NoError(err)
+			deps.ResetGasMeter()
+			_, _, err = evmtest.CallContractTx(
+				&deps, precompile.PrecompileAddr_TokenFactory, input, deps.Sender,
+			)
+			s.Require().ErrorContains(err, tc.wantError)
+		})
+	}
+}
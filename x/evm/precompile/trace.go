@@ -0,0 +1,183 @@
+package precompile
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+// Precompiles do work outside of the EVM interpreter, such as ERC20 calls made
+// through the EVM keeper, bank transfers, and Wasm executions. The interpreter
+// only reports the call to the precompile itself to the active
+// [vm.EVMLogger], so the functions in this file report that work as child
+// frames of the precompile call. With the "callTracer", they appear in the
+// "calls" of the precompile frame.
+//
+// There are two kinds of child frames:
+//  1. ERC20 calls are "CALL" frames from the caller of the ERC20 method to the
+//     ERC20 contract, with the ABI-encoded input and the output of the call.
+//  2. Cosmos calls are "CALL" frames from the precompile to itself. Their
+//     input is the JSON encoding of a [CosmosCallTrace], which names the
+//     precompile method, the Cosmos msg or keeper method, and its arguments.
+
+// CosmosCallTrace is the JSON input of the child frame reported to the active
+// [vm.EVMLogger] when a precompile executes a Cosmos msg or keeper method.
+type CosmosCallTrace struct {
+	// Method is the name of the precompile method that was called, for
+	// example "sendToBank".
+	Method string `json:"method"`
+	// Call is the type URL of the Cosmos msg or query, for example
+	// "/cosmwasm.wasm.v1.MsgExecuteContract", or the keeper method, for
+	// example "bank.MintCoins".
+	Call string `json:"call"`
+	// Args are the arguments of the call.
+	Args any `json:"args"`
+}
+
+// isTracing returns true if the EVM reports to a tracer that records
+// execution. Child frames are skipped for the no-op tracer used by txs that
+// aren't traced.
+func (start OnRunStartResult) isTracing() bool {
+	if start.EVM == nil || !start.EVM.Config.Debug || start.EVM.Config.Tracer == nil {
+		return false
+	}
+	switch start.EVM.Config.Tracer.(type) {
+	case evm.NoOpTracer, *evm.NoOpTracer:
+		return false
+	}
+	return true
+}
+
+// traceFrame reports a child frame of the precompile call to the active
+// [vm.EVMLogger].
+func traceFrame(
+	start OnRunStartResult,
+	from, to gethcommon.Address,
+	input []byte,
+	gas uint64,
+	value *big.Int,
+	output []byte,
+	gasUsed uint64,
+	err error,
+) {
+	tracer := start.EVM.Config.Tracer
+	tracer.CaptureEnter(vm.CALL, from, to, input, gas, value)
+	tracer.CaptureExit(output, gasUsed, err)
+}
+
+// traceERC20Call reports a call to the ERC20 method "methodName", made by
+// "from" through the EVM keeper, as a child frame of the precompile call.
+// "resp" and "callErr" are the results of the call. The response is nil when
+// the call fails, in which case the whole gas limit is reported as used.
+func traceERC20Call(
+	start OnRunStartResult,
+	from, erc20 gethcommon.Address,
+	resp *evm.MsgEthereumTxResponse,
+	callErr error,
+	methodName string,
+	args ...any,
+) {
+	if !start.isTracing() {
+		return
+	}
+	input, err := embeds.SmartContract_ERC20Minter.ABI.Pack(methodName, args...)
+	if err != nil {
+		input = nil
+	}
+	var (
+		output  []byte
+		gasUsed = evmkeeper.Erc20GasLimitExecute
+	)
+	if resp != nil {
+		output, gasUsed = resp.Ret, resp.GasUsed
+	}
+	traceFrame(
+		start, from, erc20, input, evmkeeper.Erc20GasLimitExecute, big.NewInt(0),
+		output, gasUsed, callErr,
+	)
+}
+
+// traceCosmosCall runs "fn", which executes a Cosmos msg or keeper method on
+// behalf of the precompile at "precompile", and reports it as a child frame of
+// the precompile call. The gas used by the frame is the gas "fn" consumes on
+// the precompile's gas meter. Returns the results of "fn".
+func traceCosmosCall(
+	start OnRunStartResult,
+	precompile gethcommon.Address,
+	call string,
+	args any,
+	fn func() ([]byte, error),
+) ([]byte, error) {
+	if !start.isTracing() {
+		return fn()
+	}
+
+	gasMeter := start.CacheCtx.GasMeter()
+	gasBefore := gasMeter.GasConsumed()
+	gasLeft := gasMeter.Limit() - gasBefore
+	var (
+		output []byte
+		err    error
+	)
+	// The frame is reported even if "fn" panics, for example when it runs out
+	// of gas, and the panic is passed on to [HandleOutOfGasPanic].
+	defer func() {
+		r := recover()
+		if r != nil {
+			err = fmt.Errorf("panic: %v", r)
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = vm.ErrOutOfGas
+			}
+		}
+		gasUsed := gasMeter.GasConsumed() - gasBefore
+		if gasUsed > gasLeft {
+			gasUsed = gasLeft
+		}
+		input, e := json.Marshal(CosmosCallTrace{
+			Method: start.Method.Name,
+			Call:   call,
+			Args:   args,
+		})
+		if e != nil {
+			input = nil
+		}
+		traceFrame(start, precompile, precompile, input, gasLeft, nil, output, gasUsed, err)
+		if r != nil {
+			panic(r)
+		}
+	}()
+	output, err = fn()
+	return output, err
+}
+
+// traceBankCall is [traceCosmosCall] for bank keeper methods that return no
+// output.
+func traceBankCall(
+	start OnRunStartResult,
+	precompile gethcommon.Address,
+	call string,
+	args any,
+	fn func() error,
+) error {
+	_, err := traceCosmosCall(start, precompile, call, args, func() ([]byte, error) {
+		return nil, fn()
+	})
+	return err
+}
+
+// bankModuleCall is the [CosmosCallTrace] args of the bank keeper methods
+// that move coins between a module account and an account or mint and burn
+// coins.
+type bankModuleCall struct {
+	Module  string    `json:"module"`
+	Account string    `json:"account,omitempty"`
+	Amount  sdk.Coins `json:"amount"`
+}
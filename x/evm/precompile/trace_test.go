@@ -0,0 +1,103 @@
+package precompile_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+// callFrame is the JSON output of the native "callTracer".
+type callFrame struct {
+	Type    string      `json:"type"`
+	From    string      `json:"from"`
+	To      string      `json:"to"`
+	Input   string      `json:"input"`
+	Output  string      `json:"output"`
+	Error   string      `json:"error"`
+	GasUsed string      `json:"gasUsed"`
+	Calls   []callFrame `json:"calls"`
+}
+
+func (s *FuntokenSuite) TestCallTracerSubCalls() {
+	deps := evmtest.NewTestDeps()
+	alice := evmtest.NewEthPrivAcc()
+	funtoken := evmtest.CreateFunTokenForBankCoin(&deps, "ufoo", &s.Suite)
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewCoin(funtoken.BankDenom, sdk.NewInt(69_420))),
+	))
+
+	traceSendToEvm := func(amount int64) callFrame {
+		input, err := embeds.SmartContract_FunToken.ABI.Pack(
+			string(precompile.FunTokenMethod_sendToEvm),
+			funtoken.BankDenom, big.NewInt(amount), alice.EthAddr.Hex(),
+		)
+		s.Require().NoError(err)
+		gas := evmtest.DefaultEthCallGasLimit
+		txArgs := evm.JsonTxArgs{
+			From: &deps.Sender.EthAddr,
+			To:   &precompile.PrecompileAddr_FunToken,
+			Data: (*hexutil.Bytes)(&input),
+			Gas:  (*hexutil.Uint64)(&gas),
+		}
+		resp, err := deps.EvmKeeper.TraceCall(deps.GoCtx(), &evm.QueryTraceTxRequest{
+			Msg:         txArgs.ToMsgEthTx(),
+			TraceConfig: &evm.TraceConfig{Tracer: "callTracer"},
+		})
+		s.Require().NoError(err)
+
+		var frame callFrame
+		s.Require().NoError(json.Unmarshal(resp.Data, &frame))
+		return frame
+	}
+	cosmosCall := func(frame callFrame) precompile.CosmosCallTrace {
+		s.Equal("CALL", frame.Type)
+		s.Equal(precompile.PrecompileAddr_FunToken, gethcommon.HexToAddress(frame.From))
+		s.Equal(precompile.PrecompileAddr_FunToken, gethcommon.HexToAddress(frame.To))
+		var call precompile.CosmosCallTrace
+		s.Require().NoError(json.Unmarshal(hexutil.MustDecode(frame.Input), &call))
+		return call
+	}
+
+	s.Run("happy: bank send and ERC20 mint are child frames", func() {
+		frame := traceSendToEvm(420)
+		s.Empty(frame.Error)
+		s.Require().Len(frame.Calls, 2, "%+v", frame)
+
+		bankCall := cosmosCall(frame.Calls[0])
+		s.Equal("sendToEvm", bankCall.Method)
+		s.Equal("bank.SendCoinsFromAccountToModule", bankCall.Call)
+		s.Contains(frame.Calls[0].Input, hexutil.Encode([]byte(`"amount":[{"denom":"ufoo","amount":"420"}]`))[2:])
+		s.Empty(frame.Calls[0].Error)
+
+		mintCall := frame.Calls[1]
+		s.Equal("CALL", mintCall.Type)
+		s.Equal(evm.EVM_MODULE_ADDRESS, gethcommon.HexToAddress(mintCall.From))
+		s.Equal(funtoken.Erc20Addr.Address, gethcommon.HexToAddress(mintCall.To))
+		wantInput, err := embeds.SmartContract_ERC20Minter.ABI.Pack("mint", alice.EthAddr, big.NewInt(420))
+		s.Require().NoError(err)
+		s.Equal(hexutil.Encode(wantInput), mintCall.Input)
+		s.Empty(mintCall.Error)
+		s.NotEqual("0x0", mintCall.GasUsed)
+	})
+
+	s.Run("sad: failed bank send is a child frame with an error", func() {
+		frame := traceSendToEvm(70_000)
+		s.NotEmpty(frame.Error)
+		s.Require().Len(frame.Calls, 1, "%+v", frame)
+		bankCall := cosmosCall(frame.Calls[0])
+		s.Equal("bank.SendCoinsFromAccountToModule", bankCall.Call)
+		s.Contains(frame.Calls[0].Error, "insufficient funds")
+	})
+}
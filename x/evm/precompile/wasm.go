@@ -35,49 +35,13 @@ const (
 func (p precompileWasm) Run(
 	evm *vm.EVM, contract *vm.Contract, readonly bool,
 ) (bz []byte, err error) {
-	defer func() {
-		err = ErrPrecompileRun(err, p)
-	}()
-	startResult, err := OnRunStart(evm, contract.Input, p.ABI(), contract.Gas)
-	if err != nil {
-		return nil, err
-	}
-
-	// Gracefully handles "out of gas"
-	defer HandleOutOfGasPanic(&err)()
-
-	// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
-	// any operation that has the potential to use Bank send methods. This will
-	// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
-	// recorded if wei (NIBI) is transferred.
-	p.Bank.StateDB = startResult.StateDB
-	switch PrecompileMethod(startResult.Method.Name) {
-	case WasmMethod_execute:
-		bz, err = p.execute(startResult, contract.CallerAddress, readonly)
-	case WasmMethod_query:
-		bz, err = p.query(startResult, contract)
-	case WasmMethod_instantiate:
-		bz, err = p.instantiate(startResult, contract.CallerAddress, readonly)
-	case WasmMethod_executeMulti:
-		bz, err = p.executeMulti(startResult, contract.CallerAddress, readonly)
-	case WasmMethod_queryRaw:
-		bz, err = p.queryRaw(startResult, contract)
-	default:
-		// Note that this code path should be impossible to reach since
-		// "[decomposeInput]" parses methods directly from the ABI.
-		err = fmt.Errorf("invalid method called with name \"%s\"", startResult.Method.Name)
-		return
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	// Gas consumed by a local gas meter
-	// The reason it's unnecessary to check for a success value is because
-	// GasConsumed is guaranteed to be less than the contract.Gas because the gas
-	// meter was initialized....
-	contract.UseGas(startResult.CacheCtx.GasMeter().GasConsumed())
-	return bz, err
+	return runPrecompile(p, evm, contract, readonly, p.Bank, map[PrecompileMethod]precompileMethod{
+		WasmMethod_execute:      p.execute,
+		WasmMethod_query:        p.query,
+		WasmMethod_instantiate:  p.instantiate,
+		WasmMethod_executeMulti: p.executeMulti,
+		WasmMethod_queryRaw:     p.queryRaw,
+	})
 }
 
 type precompileWasm struct {
@@ -137,27 +101,24 @@ func PrecompileWasm(keepers keepers.PublicKeepers) vm.PrecompiledContract {
 func (p precompileWasm) execute(
 	start OnRunStartResult,
 	caller gethcommon.Address,
-	readOnly bool,
 ) (bz []byte, err error) {
-	method, args, ctx := start.Method, start.Args, start.CacheCtx
-	defer func() {
-		if err != nil {
-			err = ErrMethodCalled(method, err)
-		}
-	}()
-	if err := assertNotReadonlyTx(readOnly, method); err != nil {
-		return nil, err
-	}
+	method, args := start.Method, start.Args
 
 	wasmContract, msgArgsBz, funds, err := p.parseArgsWasmExecute(args)
 	if err != nil {
 		err = ErrInvalidArgs(err)
 		return
 	}
-	data, err := p.Wasm.Execute(ctx, wasmContract, eth.EthAddrToNibiruAddr(caller), msgArgsBz, funds)
+	callerBech32 := eth.EthAddrToNibiruAddr(caller)
+	data, err := p.traceWasmExecute(start, callerBech32, wasmContract, msgArgsBz, funds)
 	if err != nil {
 		return
 	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "WasmExecute", caller, wasmContract.String(), msgArgsBz,
+	); err != nil {
+		return
+	}
 	return method.Outputs.Pack(data)
 }
 
@@ -175,24 +136,25 @@ func (p precompileWasm) execute(
 //	```
 func (p precompileWasm) query(
 	start OnRunStartResult,
-	contract *vm.Contract,
+	_ gethcommon.Address,
 ) (bz []byte, err error) {
 	method, args, ctx := start.Method, start.Args, start.CacheCtx
-	defer func() {
-		if err != nil {
-			err = ErrMethodCalled(method, err)
-		}
-	}()
-	if err := assertContractQuery(contract); err != nil {
-		return bz, err
-	}
 
 	wasmContract, req, err := p.parseArgsWasmQuery(args)
 	if err != nil {
 		err = ErrInvalidArgs(err)
 		return
 	}
-	respBz, err := p.Wasm.QuerySmart(ctx, wasmContract, req)
+	queryReq := &wasm.QuerySmartContractStateRequest{
+		Address:   wasmContract.String(),
+		QueryData: req,
+	}
+	respBz, err := traceCosmosCall(
+		start, p.Address(), "/cosmwasm.wasm.v1.Query/SmartContractState", queryReq,
+		func() ([]byte, error) {
+			return p.Wasm.QuerySmart(ctx, wasmContract, req)
+		},
+	)
 	if err != nil {
 		return
 	}
@@ -221,17 +183,8 @@ func (p precompileWasm) query(
 func (p precompileWasm) instantiate(
 	start OnRunStartResult,
 	caller gethcommon.Address,
-	readOnly bool,
 ) (bz []byte, err error) {
 	method, args, ctx := start.Method, start.Args, start.CacheCtx
-	defer func() {
-		if err != nil {
-			err = ErrMethodCalled(method, err)
-		}
-	}()
-	if err := assertNotReadonlyTx(readOnly, method); err != nil {
-		return nil, err
-	}
 
 	callerBech32 := eth.EthAddrToNibiruAddr(caller)
 	txMsg, err := p.parseArgsWasmInstantiate(args, callerBech32.String())
@@ -244,12 +197,24 @@ func (p precompileWasm) instantiate(
 	if len(txMsg.Admin) > 0 {
 		adminAddr = sdk.MustAccAddressFromBech32(txMsg.Admin) // validated in parse
 	}
-	contractAddr, data, err := p.Wasm.Instantiate(
-		ctx, txMsg.CodeID, callerBech32, adminAddr, txMsg.Msg, txMsg.Label, txMsg.Funds,
+	var contractAddr sdk.AccAddress
+	data, err := traceCosmosCall(
+		start, p.Address(), sdk.MsgTypeURL(&txMsg), &txMsg,
+		func() (data []byte, err error) {
+			contractAddr, data, err = p.Wasm.Instantiate(
+				ctx, txMsg.CodeID, callerBech32, adminAddr, txMsg.Msg, txMsg.Label, txMsg.Funds,
+			)
+			return data, err
+		},
 	)
 	if err != nil {
 		return
 	}
+	if err = emitEventLog(
+		start, p.Address(), p.ABI(), "WasmInstantiate", caller, txMsg.CodeID, contractAddr.String(),
+	); err != nil {
+		return
+	}
 
 	return method.Outputs.Pack(contractAddr.String(), data)
 }
@@ -272,17 +237,8 @@ func (p precompileWasm) instantiate(
 func (p precompileWasm) executeMulti(
 	start OnRunStartResult,
 	caller gethcommon.Address,
-	readOnly bool,
 ) (bz []byte, err error) {
-	method, args, ctx := start.Method, start.Args, start.CacheCtx
-	defer func() {
-		if err != nil {
-			err = ErrMethodCalled(method, err)
-		}
-	}()
-	if err := assertNotReadonlyTx(readOnly, method); err != nil {
-		return nil, err
-	}
+	method, args := start.Method, start.Args
 
 	wasmExecMsgs, err := p.parseArgsWasmExecuteMulti(args)
 	if err != nil {
@@ -310,11 +266,17 @@ func (p precompileWasm) executeMulti(
 				Amount: sdk.NewIntFromBigInt(fund.Amount),
 			})
 		}
-		respBz, e := p.Wasm.Execute(ctx, wasmContract, callerBech32, m.MsgArgs, funds)
+		respBz, e := p.traceWasmExecute(start, callerBech32, wasmContract, m.MsgArgs, funds)
 		if e != nil {
 			err = fmt.Errorf("Execute failed at index %d: %w", i, e)
 			return
 		}
+		if e := emitEventLog(
+			start, p.Address(), p.ABI(), "WasmExecute", caller, m.ContractAddr, m.MsgArgs,
+		); e != nil {
+			err = fmt.Errorf("Execute failed at index %d: %w", i, e)
+			return
+		}
 		responses = append(responses, respBz)
 	}
 	return method.Outputs.Pack(responses)
@@ -331,27 +293,16 @@ func (p precompileWasm) executeMulti(
 //	```
 //
 // Parameters:
-//   - ctx: The SDK context for the query
-//   - method: The ABI method being called
-//   - args: The arguments passed to the method
-//   - contract: The EVM contract context
+//   - start: The decoded method, its args, and the cached SDK context
 //
 // Returns:
 //   - bz: The encoded raw data stored at the queried key
 //   - err: Any error that occurred during the query
 func (p precompileWasm) queryRaw(
 	start OnRunStartResult,
-	contract *vm.Contract,
+	_ gethcommon.Address,
 ) (bz []byte, err error) {
 	method, args, ctx := start.Method, start.Args, start.CacheCtx
-	defer func() {
-		if err != nil {
-			err = ErrMethodCalled(method, err)
-		}
-	}()
-	if err := assertContractQuery(contract); err != nil {
-		return bz, err
-	}
 
 	if e := assertNumArgs(args, 2); e != nil {
 		err = e
@@ -372,6 +323,38 @@ func (p precompileWasm) queryRaw(
 		return
 	}
 
-	respBz := p.Wasm.QueryRaw(ctx, wasmContract, []byte(key))
+	queryReq := &wasm.QueryRawContractStateRequest{
+		Address:   wasmContract.String(),
+		QueryData: key,
+	}
+	respBz, _ := traceCosmosCall(
+		start, p.Address(), "/cosmwasm.wasm.v1.Query/RawContractState", queryReq,
+		func() ([]byte, error) {
+			return p.Wasm.QueryRaw(ctx, wasmContract, key), nil
+		},
+	)
 	return method.Outputs.Pack(respBz)
 }
+
+// traceWasmExecute executes a Wasm contract and reports the execution to the
+// active tracer as a "MsgExecuteContract" child frame of the precompile call.
+func (p precompileWasm) traceWasmExecute(
+	start OnRunStartResult,
+	sender sdk.AccAddress,
+	wasmContract sdk.AccAddress,
+	msgArgs []byte,
+	funds sdk.Coins,
+) ([]byte, error) {
+	txMsg := &wasm.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: wasmContract.String(),
+		Msg:      msgArgs,
+		Funds:    funds,
+	}
+	return traceCosmosCall(
+		start, p.Address(), sdk.MsgTypeURL(txMsg), txMsg,
+		func() ([]byte, error) {
+			return p.Wasm.Execute(start.CacheCtx, wasmContract, sender, msgArgs, funds)
+		},
+	)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+)
+
+// MaxSimulateBlocks is the maximum number of blocks, including the empty
+// blocks filling gaps between block numbers, that a single "eth_simulateV1"
+// request can simulate.
+const MaxSimulateBlocks = 256
+
+// MaxSimulateCalls is the maximum number of calls, over all the blocks, that
+// a single "eth_simulateV1" request can simulate.
+const MaxSimulateCalls = 1000
+
+// SimulateTimestampIncrement is the default number of seconds between the
+// timestamps of two consecutive simulated blocks.
+const SimulateTimestampIncrement = 12
+
+// JSON-RPC error codes of "eth_simulateV1", as defined by the execution-apis
+// spec. Errors with these codes abort the whole simulation.
+const (
+	SimErrCodeNonceTooLow       = -38010
+	SimErrCodeNonceTooHigh      = -38011
+	SimErrCodeBaseFeeTooLow     = -38012
+	SimErrCodeIntrinsicGas      = -38013
+	SimErrCodeInsufficientFunds = -38014
+	SimErrCodeBlockGasLimit     = -38015
+	SimErrCodeBlockNumberOrder  = -38020
+	SimErrCodeBlockTimestamp    = -38021
+	SimErrCodeClientLimit       = -38026
+	SimErrCodeInvalidParams     = -32602
+	SimErrCodeExecutionReverted = 3
+	SimErrCodeVMError           = -32015
+)
+
+// SimOpts are the options of an "eth_simulateV1" request.
+type SimOpts struct {
+	// BlockStateCalls are the blocks to simulate, in order.
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	// TraceTransfers adds an ERC20-like "Transfer" log, emitted by
+	// [SimTransferLogAddress], for every transfer of ether.
+	TraceTransfers bool `json:"traceTransfers"`
+	// Validation enables the nonce, balance and base fee checks that a tx has
+	// to pass to be included in a block, and charges the gas fees.
+	Validation bool `json:"validation"`
+	// ReturnFullTransactions returns the txs of the simulated blocks as
+	// objects instead of hashes.
+	ReturnFullTransactions bool `json:"returnFullTransactions"`
+}
+
+// SimBlock is a simulated block: overrides of the block header and of the
+// state, applied before the calls of the block.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride  `json:"stateOverrides,omitempty"`
+	Calls          []JsonTxArgs    `json:"calls"`
+}
+
+// BlockOverrides are the overridden fields of the header of a simulated block.
+// "prevRandao" and "blobBaseFee" are accepted but have no effect, since the
+// EVM of Nibiru doesn't support them.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number,omitempty"`
+	Time          *hexutil.Uint64 `json:"time,omitempty"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit,omitempty"`
+	FeeRecipient  *common.Address `json:"feeRecipient,omitempty"`
+	PrevRandao    *common.Hash    `json:"prevRandao,omitempty"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	BlobBaseFee   *hexutil.Big    `json:"blobBaseFee,omitempty"`
+}
+
+// SimTransferLogAddress is the address that emits the transfer logs of a
+// simulation with "traceTransfers".
+var SimTransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+// SimulatedBlock is the result of a simulated block, as returned by the
+// SimulateV1 query. The JSON-RPC layer formats it as a block object.
+type SimulatedBlock struct {
+	Header *gethcore.Header `json:"header"`
+	// Txs are the unsigned txs built from the calls of the block.
+	Txs []SimulatedTx `json:"txs"`
+	// Calls are the results of the calls of the block.
+	Calls []SimCallResult `json:"calls"`
+}
+
+// SimulatedTx is an unsigned tx built from a simulated call and its sender.
+type SimulatedTx struct {
+	// Tx is the binary encoding of the tx.
+	Tx   hexutil.Bytes  `json:"tx"`
+	From common.Address `json:"from"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*gethcore.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a simulated call that failed.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimError is an error that aborts a simulation, with its JSON-RPC error code.
+type SimError struct {
+	Code    int
+	Message string
+}
+
+// NewSimError returns a [SimError] with a formatted message.
+func NewSimError(code int, format string, args ...any) *SimError {
+	return &SimError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *SimError) Error() string { return e.Message }
+
+// ErrorCode returns the JSON-RPC error code of the error.
+func (e *SimError) ErrorCode() int { return e.Code }
@@ -671,6 +671,103 @@ func (m *MsgConvertCoinToEvmResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertCoinToEvmResponse proto.InternalMessageInfo
 
+// MsgConvertEvmToCoin: Arguments to send ERC-20 tokens to their Bank Coin
+// representation
+type MsgConvertEvmToCoin struct {
+	// Sender: Address for the signer of the transaction. The ERC20 tokens are
+	// taken from the Ethereum address corresponding to the sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token of the `FunToken` mapping
+	Erc20Addr github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"erc20_addr"`
+	// Amount of ERC20 tokens to convert to Bank Coins
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// Bech32 address of the recipient of the Bank Coins
+	ToBankAddr string `protobuf:"bytes,4,opt,name=to_bank_addr,json=toBankAddr,proto3" json:"to_bank_addr,omitempty"`
+}
+
+func (m *MsgConvertEvmToCoin) Reset()         { *m = MsgConvertEvmToCoin{} }
+func (m *MsgConvertEvmToCoin) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToCoin) ProtoMessage()    {}
+func (*MsgConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{12}
+}
+func (m *MsgConvertEvmToCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertEvmToCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertEvmToCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToCoin.Merge(m, src)
+}
+func (m *MsgConvertEvmToCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertEvmToCoin proto.InternalMessageInfo
+
+func (m *MsgConvertEvmToCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgConvertEvmToCoin) GetToBankAddr() string {
+	if m != nil {
+		return m.ToBankAddr
+	}
+	return ""
+}
+
+type MsgConvertEvmToCoinResponse struct {
+}
+
+func (m *MsgConvertEvmToCoinResponse) Reset()         { *m = MsgConvertEvmToCoinResponse{} }
+func (m *MsgConvertEvmToCoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToCoinResponse) ProtoMessage()    {}
+func (*MsgConvertEvmToCoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{13}
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToCoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToCoinResponse.Merge(m, src)
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToCoinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertEvmToCoinResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "eth.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "eth.evm.v1.LegacyTx")
@@ -684,90 +781,97 @@ func init() {
 	proto.RegisterType((*MsgCreateFunTokenResponse)(nil), "eth.evm.v1.MsgCreateFunTokenResponse")
 	proto.RegisterType((*MsgConvertCoinToEvm)(nil), "eth.evm.v1.MsgConvertCoinToEvm")
 	proto.RegisterType((*MsgConvertCoinToEvmResponse)(nil), "eth.evm.v1.MsgConvertCoinToEvmResponse")
+	proto.RegisterType((*MsgConvertEvmToCoin)(nil), "eth.evm.v1.MsgConvertEvmToCoin")
+	proto.RegisterType((*MsgConvertEvmToCoinResponse)(nil), "eth.evm.v1.MsgConvertEvmToCoinResponse")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/tx.proto", fileDescriptor_82a0bfe4f0bab953) }
 
 var fileDescriptor_82a0bfe4f0bab953 = []byte{
-	// 1246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x8e, 0x7f, 0x3c, 0xbb, 0x49, 0xbe, 0xdb, 0xf4, 0xdb, 0xb5, 0xdb, 0x7a, 0xc3,
-	0x56, 0x94, 0x08, 0x29, 0xbb, 0x8d, 0x51, 0x2b, 0x35, 0x27, 0xe2, 0xc4, 0x45, 0x45, 0x09, 0x44,
-	0x8b, 0xd3, 0x03, 0x42, 0xb2, 0xc6, 0xbb, 0x93, 0xf5, 0x2a, 0xd9, 0x99, 0xd5, 0xce, 0x78, 0xe5,
-	0x70, 0xec, 0x09, 0x89, 0x03, 0x20, 0xee, 0x88, 0x73, 0x4f, 0x1c, 0x7a, 0xe0, 0x4f, 0xa8, 0x38,
-	0x55, 0x70, 0x00, 0xf5, 0x60, 0x50, 0x8a, 0x84, 0xd4, 0x63, 0x0f, 0xdc, 0x90, 0xd0, 0xcc, 0xae,
-	0x1d, 0x3b, 0x21, 0x29, 0x54, 0x88, 0xdb, 0xbc, 0x79, 0x3f, 0xe6, 0xbd, 0xcf, 0xe7, 0xcd, 0x9b,
-	0x81, 0x8b, 0x98, 0xf7, 0x2c, 0x1c, 0x07, 0x56, 0xbc, 0x6a, 0xf1, 0x81, 0x19, 0x46, 0x94, 0x53,
-	0x15, 0x30, 0xef, 0x99, 0x38, 0x0e, 0xcc, 0x78, 0xb5, 0x76, 0xd9, 0xa1, 0x2c, 0xa0, 0xcc, 0x0a,
-	0x98, 0x27, 0x6c, 0x02, 0xe6, 0x25, 0x46, 0xb5, 0x7a, 0xaa, 0xe8, 0x22, 0x86, 0xad, 0x78, 0xb5,
-	0x8b, 0x39, 0x5a, 0xb5, 0x1c, 0xea, 0x93, 0x54, 0x5f, 0x4d, 0xf4, 0x1d, 0x29, 0x59, 0x89, 0x90,
-	0xaa, 0x16, 0x27, 0x0e, 0x15, 0xc7, 0xa4, 0xbb, 0x1e, 0xf5, 0x68, 0x62, 0x2d, 0x56, 0xe9, 0xee,
-	0x55, 0x8f, 0x52, 0xef, 0x00, 0x5b, 0x28, 0xf4, 0x2d, 0x44, 0x08, 0xe5, 0x88, 0xfb, 0x94, 0x8c,
-	0x22, 0x55, 0x53, 0xad, 0x94, 0xba, 0xfd, 0x3d, 0x0b, 0x91, 0xc3, 0x44, 0x65, 0x7c, 0xa6, 0xc0,
-	0x85, 0x6d, 0xe6, 0xb5, 0x78, 0x0f, 0x47, 0xb8, 0x1f, 0xb4, 0x07, 0xea, 0x32, 0xe4, 0x5c, 0xc4,
-	0x91, 0xa6, 0x2c, 0x29, 0xcb, 0xe5, 0xc6, 0xa2, 0x99, 0xf8, 0x9a, 0x23, 0x5f, 0x73, 0x9d, 0x1c,
-	0xda, 0xd2, 0x42, 0xad, 0x42, 0x8e, 0xf9, 0x1f, 0x63, 0x2d, 0xb3, 0xa4, 0x2c, 0x2b, 0xcd, 0xd9,
-	0xe7, 0x43, 0x5d, 0x59, 0xb1, 0xe5, 0x96, 0xaa, 0x43, 0xae, 0x87, 0x58, 0x4f, 0xcb, 0x2e, 0x29,
-	0xcb, 0xa5, 0x66, 0xf9, 0xc5, 0x50, 0x2f, 0x44, 0x07, 0xe1, 0x9a, 0xb1, 0x62, 0xd8, 0x52, 0xa1,
-	0xaa, 0x90, 0xdb, 0x8b, 0x68, 0xa0, 0xe5, 0x84, 0x81, 0x2d, 0xd7, 0x6b, 0xb9, 0x4f, 0xbe, 0xd6,
-	0x67, 0x8c, 0x2f, 0x32, 0x50, 0xdc, 0xc2, 0x1e, 0x72, 0x0e, 0xdb, 0x03, 0x75, 0x11, 0x66, 0x09,
-	0x25, 0x0e, 0x96, 0xd9, 0xe4, 0xec, 0x44, 0x50, 0x6f, 0x43, 0xc9, 0x43, 0x02, 0x33, 0xdf, 0x49,
-	0x4e, 0x2f, 0x35, 0xab, 0x4f, 0x87, 0xfa, 0xa5, 0x04, 0x3e, 0xe6, 0xee, 0x9b, 0x3e, 0xb5, 0x02,
-	0xc4, 0x7b, 0xe6, 0x3d, 0xc2, 0xed, 0xa2, 0x87, 0xd8, 0x8e, 0x30, 0x55, 0xeb, 0x90, 0xf5, 0x10,
-	0x93, 0x49, 0xe5, 0x9a, 0x95, 0xa3, 0xa1, 0x5e, 0x7c, 0x07, 0xb1, 0x2d, 0x3f, 0xf0, 0xb9, 0x2d,
-	0x14, 0xea, 0x1c, 0x64, 0x38, 0x4d, 0x53, 0xca, 0x70, 0xaa, 0xde, 0x81, 0xd9, 0x18, 0x1d, 0xf4,
-	0xb1, 0x36, 0x2b, 0xcf, 0xb8, 0x7e, 0xe6, 0x19, 0x47, 0x43, 0x3d, 0xbf, 0x1e, 0xd0, 0x3e, 0xe1,
-	0x76, 0xe2, 0x21, 0xea, 0x93, 0x28, 0xe6, 0x97, 0x94, 0xe5, 0x4a, 0x8a, 0x57, 0x05, 0x94, 0x58,
-	0x2b, 0xc8, 0x0d, 0x25, 0x16, 0x52, 0xa4, 0x15, 0x13, 0x29, 0x12, 0x12, 0xd3, 0x4a, 0x89, 0xc4,
-	0xd6, 0xe6, 0x04, 0x12, 0xdf, 0x3d, 0x5a, 0xc9, 0xb7, 0x07, 0x9b, 0x88, 0x23, 0xe3, 0xdb, 0x2c,
-	0x54, 0xd6, 0x1d, 0x07, 0x33, 0xb6, 0xe5, 0x33, 0xde, 0x1e, 0xa8, 0xef, 0x42, 0xd1, 0xe9, 0x21,
-	0x9f, 0x74, 0x7c, 0x57, 0x42, 0x53, 0x6a, 0x5a, 0xe7, 0x25, 0x57, 0xd8, 0x10, 0xc6, 0xf7, 0x36,
-	0x9f, 0x0f, 0xf5, 0x82, 0x93, 0x2c, 0xed, 0x74, 0xe1, 0x1e, 0x63, 0x9c, 0x39, 0x13, 0xe3, 0xec,
-	0x3f, 0xc6, 0x38, 0x77, 0x3e, 0xc6, 0xb3, 0xa7, 0x31, 0xce, 0xbf, 0x32, 0xc6, 0x85, 0x09, 0x8c,
-	0x77, 0xa1, 0x88, 0x24, 0x50, 0x98, 0x69, 0xc5, 0xa5, 0xec, 0x72, 0xb9, 0x71, 0xd9, 0x3c, 0xbe,
-	0xa7, 0x66, 0x02, 0x62, 0xbb, 0x1f, 0x1e, 0xe0, 0xe6, 0xd2, 0xe3, 0xa1, 0x3e, 0xf3, 0x7c, 0xa8,
-	0x03, 0x1a, 0x23, 0xfb, 0xf0, 0x67, 0x1d, 0x8e, 0x71, 0xb6, 0xc7, 0xa1, 0x12, 0xea, 0x4a, 0x53,
-	0xd4, 0xc1, 0x14, 0x75, 0xe5, 0xb3, 0xa8, 0xfb, 0x3d, 0x0b, 0x95, 0xcd, 0x43, 0x82, 0x02, 0xdf,
-	0xb9, 0x8b, 0xf1, 0x7f, 0x42, 0xdd, 0x1d, 0x28, 0x0b, 0xea, 0xb8, 0x1f, 0x76, 0x1c, 0x14, 0xbe,
-	0x9c, 0x3c, 0x41, 0x74, 0xdb, 0x0f, 0x37, 0x50, 0x38, 0x72, 0xdd, 0xc3, 0x58, 0xba, 0xe6, 0xfe,
-	0x8e, 0xeb, 0x5d, 0x8c, 0x85, 0x6b, 0x4a, 0xfc, 0xec, 0xf9, 0xc4, 0xe7, 0x4f, 0x13, 0x5f, 0x78,
-	0x65, 0xe2, 0x8b, 0x67, 0x10, 0x5f, 0xfa, 0x97, 0x89, 0x87, 0x29, 0xe2, 0xcb, 0x53, 0xc4, 0x57,
-	0xce, 0x22, 0xde, 0x80, 0x5a, 0x6b, 0xc0, 0x31, 0x61, 0x3e, 0x25, 0xef, 0x87, 0x72, 0x1c, 0x1f,
-	0x4f, 0xd9, 0x74, 0xd6, 0x7d, 0xa5, 0xc0, 0xa5, 0xa9, 0xe9, 0x6b, 0x63, 0x16, 0x52, 0xc2, 0x64,
-	0x89, 0x72, 0x80, 0x2a, 0xc9, 0x7c, 0x94, 0x33, 0xf3, 0x3a, 0xe4, 0x0e, 0xa8, 0xc7, 0xb4, 0x8c,
-	0x2c, 0x6f, 0x7e, 0xb2, 0xbc, 0x2d, 0xea, 0xd9, 0x52, 0xa9, 0x2e, 0x40, 0x36, 0xc2, 0x5c, 0x92,
-	0x5e, 0xb1, 0xc5, 0x52, 0xad, 0x42, 0x31, 0x0e, 0x3a, 0x38, 0x8a, 0x68, 0x94, 0xce, 0xb6, 0x42,
-	0x1c, 0xb4, 0x84, 0x28, 0x54, 0x82, 0xee, 0x3e, 0xc3, 0x6e, 0x42, 0x9c, 0x5d, 0xf0, 0x10, 0xdb,
-	0x65, 0xd8, 0x4d, 0x13, 0xfc, 0x54, 0x81, 0xf9, 0x6d, 0xe6, 0xed, 0x86, 0x2e, 0xe2, 0x78, 0x07,
-	0x45, 0x28, 0x60, 0x62, 0x32, 0xa0, 0x3e, 0xef, 0xd1, 0xc8, 0xe7, 0x87, 0x69, 0x07, 0x6b, 0xdf,
-	0x3f, 0x5a, 0x59, 0x4c, 0x1f, 0xaf, 0x75, 0xd7, 0x8d, 0x30, 0x63, 0x1f, 0xf0, 0xc8, 0x27, 0x9e,
-	0x7d, 0x6c, 0xaa, 0xde, 0x84, 0x7c, 0x28, 0x23, 0xc8, 0x6e, 0x2d, 0x37, 0xd4, 0xc9, 0x02, 0x92,
-	0xd8, 0xcd, 0x9c, 0xa0, 0xc6, 0x4e, 0xed, 0xd6, 0xe6, 0x1e, 0xfc, 0xf6, 0xcd, 0x9b, 0xc7, 0x11,
-	0x8c, 0x2a, 0x5c, 0x3e, 0x91, 0xcc, 0x08, 0x2f, 0xe3, 0xa1, 0x02, 0xff, 0xdb, 0x66, 0xde, 0x46,
-	0x84, 0x11, 0xc7, 0x77, 0xfb, 0xa4, 0x4d, 0xf7, 0x31, 0x51, 0x77, 0x01, 0xc4, 0xcb, 0xd2, 0xc1,
-	0x91, 0xd3, 0xb8, 0x99, 0xe6, 0x7a, 0xfb, 0xf1, 0x50, 0x57, 0x9e, 0x0e, 0x75, 0xd3, 0xf3, 0x79,
-	0xaf, 0xdf, 0x35, 0x1d, 0x1a, 0x58, 0xef, 0xf9, 0x5d, 0x3f, 0xea, 0xcb, 0x9b, 0x66, 0x11, 0xb9,
-	0xb6, 0xe2, 0x86, 0x25, 0xd2, 0x6b, 0xdd, 0xdb, 0xb9, 0x75, 0x4b, 0x94, 0x64, 0x97, 0x44, 0xa4,
-	0x96, 0x08, 0xa4, 0xde, 0x80, 0x79, 0x19, 0xb6, 0x8b, 0xc8, 0x7e, 0xc7, 0xc5, 0x84, 0x06, 0xc9,
-	0x2b, 0x64, 0x5f, 0x10, 0xdb, 0x4d, 0x44, 0xf6, 0x37, 0xc5, 0xa6, 0xfa, 0x7f, 0xc8, 0x33, 0x4c,
-	0x5c, 0x1c, 0x25, 0x77, 0xd0, 0x4e, 0x25, 0xa3, 0x0b, 0xd5, 0x53, 0xb9, 0x8e, 0x99, 0x6f, 0xc1,
-	0xc2, 0x5e, 0x9f, 0x70, 0xb1, 0xd7, 0x09, 0x50, 0x18, 0xfa, 0xc4, 0x1b, 0xbf, 0xc5, 0x13, 0x80,
-	0x8d, 0xfc, 0x52, 0xc8, 0xe6, 0x47, 0x3e, 0xdb, 0x89, 0x8b, 0xf1, 0xa3, 0x02, 0x17, 0xc5, 0x21,
-	0x94, 0xc4, 0x38, 0xe2, 0x1b, 0xd4, 0x27, 0x6d, 0xda, 0x8a, 0x03, 0xf5, 0x3e, 0x94, 0x39, 0xed,
-	0x60, 0xde, 0xeb, 0x20, 0xd7, 0x8d, 0x26, 0x30, 0x99, 0x79, 0x15, 0x4c, 0x38, 0x6d, 0xf1, 0x9e,
-	0x58, 0x4e, 0xd4, 0x9a, 0x99, 0xac, 0x55, 0xdd, 0x81, 0x92, 0x84, 0x49, 0xfc, 0x79, 0x24, 0x0c,
-	0xe5, 0x46, 0xd5, 0x4c, 0x5b, 0x45, 0x7c, 0x8a, 0xcc, 0xf4, 0x53, 0x64, 0x8a, 0x14, 0x9b, 0x9a,
-	0x48, 0xe4, 0xc5, 0x50, 0x5f, 0x38, 0x44, 0xc1, 0xc1, 0x9a, 0x31, 0xf6, 0x34, 0xec, 0xa2, 0x58,
-	0x0b, 0x1b, 0xe3, 0x1a, 0x5c, 0xf9, 0x8b, 0xc2, 0x46, 0xf8, 0x35, 0xfe, 0xc8, 0x40, 0x76, 0x9b,
-	0x79, 0x2a, 0x01, 0x98, 0xf8, 0xd5, 0x54, 0x27, 0xb1, 0x9b, 0xba, 0x72, 0xb5, 0xd7, 0xce, 0x54,
-	0x8d, 0xbb, 0xcb, 0x78, 0xf0, 0xc3, 0xaf, 0x5f, 0x66, 0xae, 0x1a, 0xb5, 0x11, 0x12, 0xa3, 0x6f,
-	0x59, 0x6a, 0xda, 0xe1, 0x03, 0x75, 0x07, 0x2a, 0x53, 0xd7, 0xe4, 0xca, 0x89, 0xb0, 0x93, 0xca,
-	0xda, 0xf5, 0x73, 0x94, 0xe3, 0x4e, 0xb8, 0x0f, 0x73, 0x27, 0xfa, 0xf9, 0xda, 0x09, 0xb7, 0x69,
-	0x75, 0xed, 0xf5, 0x73, 0xd5, 0xe3, 0xb8, 0x1f, 0xc1, 0xc2, 0xa9, 0xb6, 0xd0, 0x4f, 0xba, 0x9e,
-	0x30, 0xa8, 0xbd, 0xf1, 0x12, 0x83, 0x51, 0xf4, 0xe6, 0xdb, 0x8f, 0x8f, 0xea, 0xca, 0x93, 0xa3,
-	0xba, 0xf2, 0xcb, 0x51, 0x5d, 0xf9, 0xfc, 0x59, 0x7d, 0xe6, 0xc9, 0xb3, 0xfa, 0xcc, 0x4f, 0xcf,
-	0xea, 0x33, 0x1f, 0xde, 0x78, 0x69, 0x77, 0x0d, 0x04, 0xb0, 0xdd, 0xbc, 0xfc, 0x6b, 0xbe, 0xf5,
-	0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0xcb, 0xec, 0xc3, 0x76, 0x0b, 0x00, 0x00,
+	// 1324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0xda, 0x8e, 0x3f, 0x1e, 0xbb, 0x49, 0xde, 0x6d, 0xfa, 0xd6, 0x76, 0x5b, 0xaf, 0xdf,
+	0xad, 0xde, 0x36, 0x7a, 0xa5, 0xec, 0x36, 0x7e, 0xd5, 0x4a, 0xcd, 0x89, 0x38, 0x71, 0x51, 0x51,
+	0x02, 0xd1, 0xe2, 0xf4, 0x80, 0x90, 0xac, 0xb1, 0x77, 0xb2, 0x5e, 0x25, 0x3b, 0xb3, 0xda, 0x19,
+	0xaf, 0x1c, 0x8e, 0x3d, 0x21, 0x71, 0x00, 0xc4, 0x1d, 0x71, 0xee, 0x89, 0x43, 0x0f, 0xfc, 0x09,
+	0x15, 0xa7, 0x0a, 0x0e, 0xa0, 0x22, 0x0c, 0x4a, 0x91, 0x90, 0x7a, 0xec, 0x81, 0x33, 0x9a, 0xd9,
+	0xf5, 0x57, 0x52, 0x27, 0x50, 0x10, 0xb7, 0x79, 0xe6, 0xf9, 0x98, 0xe7, 0xf9, 0xfd, 0x9e, 0x79,
+	0x66, 0xe0, 0x22, 0xe6, 0x5d, 0x13, 0x87, 0x9e, 0x19, 0xae, 0x99, 0xbc, 0x6f, 0xf8, 0x01, 0xe5,
+	0x54, 0x05, 0xcc, 0xbb, 0x06, 0x0e, 0x3d, 0x23, 0x5c, 0x2b, 0x5f, 0xee, 0x50, 0xe6, 0x51, 0x66,
+	0x7a, 0xcc, 0x11, 0x36, 0x1e, 0x73, 0x22, 0xa3, 0x72, 0x25, 0x56, 0xb4, 0x11, 0xc3, 0x66, 0xb8,
+	0xd6, 0xc6, 0x1c, 0xad, 0x99, 0x1d, 0xea, 0x92, 0x58, 0x5f, 0x8a, 0xf4, 0x2d, 0x29, 0x99, 0x91,
+	0x10, 0xab, 0x96, 0x27, 0x0e, 0x15, 0xc7, 0xc4, 0xbb, 0x0e, 0x75, 0x68, 0x64, 0x2d, 0x56, 0xf1,
+	0xee, 0x55, 0x87, 0x52, 0xe7, 0x10, 0x9b, 0xc8, 0x77, 0x4d, 0x44, 0x08, 0xe5, 0x88, 0xbb, 0x94,
+	0x0c, 0x23, 0x95, 0x62, 0xad, 0x94, 0xda, 0xbd, 0x7d, 0x13, 0x91, 0xa3, 0x48, 0xa5, 0x7f, 0xac,
+	0xc0, 0x85, 0x1d, 0xe6, 0x34, 0x78, 0x17, 0x07, 0xb8, 0xe7, 0x35, 0xfb, 0xea, 0x0a, 0xa4, 0x6c,
+	0xc4, 0x51, 0x51, 0xa9, 0x2a, 0x2b, 0xf9, 0xda, 0xb2, 0x11, 0xf9, 0x1a, 0x43, 0x5f, 0x63, 0x83,
+	0x1c, 0x59, 0xd2, 0x42, 0x2d, 0x41, 0x8a, 0xb9, 0x1f, 0xe0, 0x62, 0xa2, 0xaa, 0xac, 0x28, 0xf5,
+	0xf9, 0x17, 0x03, 0x4d, 0x59, 0xb5, 0xe4, 0x96, 0xaa, 0x41, 0xaa, 0x8b, 0x58, 0xb7, 0x98, 0xac,
+	0x2a, 0x2b, 0xb9, 0x7a, 0xfe, 0xe5, 0x40, 0xcb, 0x04, 0x87, 0xfe, 0xba, 0xbe, 0xaa, 0x5b, 0x52,
+	0xa1, 0xaa, 0x90, 0xda, 0x0f, 0xa8, 0x57, 0x4c, 0x09, 0x03, 0x4b, 0xae, 0xd7, 0x53, 0x1f, 0x7e,
+	0xa1, 0xcd, 0xe9, 0x9f, 0x26, 0x20, 0xbb, 0x8d, 0x1d, 0xd4, 0x39, 0x6a, 0xf6, 0xd5, 0x65, 0x98,
+	0x27, 0x94, 0x74, 0xb0, 0xcc, 0x26, 0x65, 0x45, 0x82, 0x7a, 0x07, 0x72, 0x0e, 0x12, 0x98, 0xb9,
+	0x9d, 0xe8, 0xf4, 0x5c, 0xbd, 0xf4, 0x6c, 0xa0, 0x5d, 0x8a, 0xe0, 0x63, 0xf6, 0x81, 0xe1, 0x52,
+	0xd3, 0x43, 0xbc, 0x6b, 0xdc, 0x27, 0xdc, 0xca, 0x3a, 0x88, 0xed, 0x0a, 0x53, 0xb5, 0x02, 0x49,
+	0x07, 0x31, 0x99, 0x54, 0xaa, 0x5e, 0x38, 0x1e, 0x68, 0xd9, 0x37, 0x11, 0xdb, 0x76, 0x3d, 0x97,
+	0x5b, 0x42, 0xa1, 0x2e, 0x40, 0x82, 0xd3, 0x38, 0xa5, 0x04, 0xa7, 0xea, 0x5d, 0x98, 0x0f, 0xd1,
+	0x61, 0x0f, 0x17, 0xe7, 0xe5, 0x19, 0xd7, 0x67, 0x9e, 0x71, 0x3c, 0xd0, 0xd2, 0x1b, 0x1e, 0xed,
+	0x11, 0x6e, 0x45, 0x1e, 0xa2, 0x3e, 0x89, 0x62, 0xba, 0xaa, 0xac, 0x14, 0x62, 0xbc, 0x0a, 0xa0,
+	0x84, 0xc5, 0x8c, 0xdc, 0x50, 0x42, 0x21, 0x05, 0xc5, 0x6c, 0x24, 0x05, 0x42, 0x62, 0xc5, 0x5c,
+	0x24, 0xb1, 0xf5, 0x05, 0x81, 0xc4, 0xd7, 0x8f, 0x57, 0xd3, 0xcd, 0xfe, 0x16, 0xe2, 0x48, 0xff,
+	0x2a, 0x09, 0x85, 0x8d, 0x4e, 0x07, 0x33, 0xb6, 0xed, 0x32, 0xde, 0xec, 0xab, 0x6f, 0x41, 0xb6,
+	0xd3, 0x45, 0x2e, 0x69, 0xb9, 0xb6, 0x84, 0x26, 0x57, 0x37, 0xcf, 0x4a, 0x2e, 0xb3, 0x29, 0x8c,
+	0xef, 0x6f, 0xbd, 0x18, 0x68, 0x99, 0x4e, 0xb4, 0xb4, 0xe2, 0x85, 0x3d, 0xc6, 0x38, 0x31, 0x13,
+	0xe3, 0xe4, 0x9f, 0xc6, 0x38, 0x75, 0x36, 0xc6, 0xf3, 0xa7, 0x31, 0x4e, 0xbf, 0x36, 0xc6, 0x99,
+	0x09, 0x8c, 0xf7, 0x20, 0x8b, 0x24, 0x50, 0x98, 0x15, 0xb3, 0xd5, 0xe4, 0x4a, 0xbe, 0x76, 0xd9,
+	0x18, 0xdf, 0x53, 0x23, 0x02, 0xb1, 0xd9, 0xf3, 0x0f, 0x71, 0xbd, 0xfa, 0x64, 0xa0, 0xcd, 0xbd,
+	0x18, 0x68, 0x80, 0x46, 0xc8, 0x3e, 0xfa, 0x49, 0x83, 0x31, 0xce, 0xd6, 0x28, 0x54, 0x44, 0x5d,
+	0x6e, 0x8a, 0x3a, 0x98, 0xa2, 0x2e, 0x3f, 0x8b, 0xba, 0xdf, 0x92, 0x50, 0xd8, 0x3a, 0x22, 0xc8,
+	0x73, 0x3b, 0xf7, 0x30, 0xfe, 0x47, 0xa8, 0xbb, 0x0b, 0x79, 0x41, 0x1d, 0x77, 0xfd, 0x56, 0x07,
+	0xf9, 0xe7, 0x93, 0x27, 0x88, 0x6e, 0xba, 0xfe, 0x26, 0xf2, 0x87, 0xae, 0xfb, 0x18, 0x4b, 0xd7,
+	0xd4, 0x1f, 0x71, 0xbd, 0x87, 0xb1, 0x70, 0x8d, 0x89, 0x9f, 0x3f, 0x9b, 0xf8, 0xf4, 0x69, 0xe2,
+	0x33, 0xaf, 0x4d, 0x7c, 0x76, 0x06, 0xf1, 0xb9, 0xbf, 0x99, 0x78, 0x98, 0x22, 0x3e, 0x3f, 0x45,
+	0x7c, 0x61, 0x16, 0xf1, 0x3a, 0x94, 0x1b, 0x7d, 0x8e, 0x09, 0x73, 0x29, 0x79, 0xc7, 0x97, 0xe3,
+	0x78, 0x3c, 0x65, 0xe3, 0x59, 0xf7, 0xb9, 0x02, 0x97, 0xa6, 0xa6, 0xaf, 0x85, 0x99, 0x4f, 0x09,
+	0x93, 0x25, 0xca, 0x01, 0xaa, 0x44, 0xf3, 0x51, 0xac, 0xd5, 0xeb, 0x90, 0x3a, 0xa4, 0x0e, 0x2b,
+	0x26, 0x64, 0x79, 0x8b, 0x93, 0xe5, 0x6d, 0x53, 0xc7, 0x92, 0x4a, 0x75, 0x09, 0x92, 0x01, 0xe6,
+	0x92, 0xf4, 0x82, 0x25, 0x96, 0x6a, 0x09, 0xb2, 0xa1, 0xd7, 0xc2, 0x41, 0x40, 0x83, 0x78, 0xb6,
+	0x65, 0x42, 0xaf, 0x21, 0x44, 0xa1, 0x12, 0x74, 0xf7, 0x18, 0xb6, 0x23, 0xe2, 0xac, 0x8c, 0x83,
+	0xd8, 0x1e, 0xc3, 0x76, 0x9c, 0xe0, 0x47, 0x0a, 0x2c, 0xee, 0x30, 0x67, 0xcf, 0xb7, 0x11, 0xc7,
+	0xbb, 0x28, 0x40, 0x1e, 0x13, 0x93, 0x01, 0xf5, 0x78, 0x97, 0x06, 0x2e, 0x3f, 0x8a, 0x3b, 0xb8,
+	0xf8, 0xcd, 0xe3, 0xd5, 0xe5, 0xf8, 0xf1, 0xda, 0xb0, 0xed, 0x00, 0x33, 0xf6, 0x2e, 0x0f, 0x5c,
+	0xe2, 0x58, 0x63, 0x53, 0xf5, 0x16, 0xa4, 0x7d, 0x19, 0x41, 0x76, 0x6b, 0xbe, 0xa6, 0x4e, 0x16,
+	0x10, 0xc5, 0xae, 0xa7, 0x04, 0x35, 0x56, 0x6c, 0xb7, 0xbe, 0xf0, 0xf0, 0xd7, 0x2f, 0xff, 0x37,
+	0x8e, 0xa0, 0x97, 0xe0, 0xf2, 0x89, 0x64, 0x86, 0x78, 0xe9, 0x8f, 0x14, 0xf8, 0xd7, 0x0e, 0x73,
+	0x36, 0x03, 0x8c, 0x38, 0xbe, 0xd7, 0x23, 0x4d, 0x7a, 0x80, 0x89, 0xba, 0x07, 0x20, 0x5e, 0x96,
+	0x16, 0x0e, 0x3a, 0xb5, 0x5b, 0x71, 0xae, 0x77, 0x9e, 0x0c, 0x34, 0xe5, 0xd9, 0x40, 0x33, 0x1c,
+	0x97, 0x77, 0x7b, 0x6d, 0xa3, 0x43, 0x3d, 0xf3, 0x6d, 0xb7, 0xed, 0x06, 0x3d, 0x79, 0xd3, 0x4c,
+	0x22, 0xd7, 0x66, 0x58, 0x33, 0x45, 0x7a, 0x8d, 0xfb, 0xbb, 0xb7, 0x6f, 0x8b, 0x92, 0xac, 0x9c,
+	0x88, 0xd4, 0x10, 0x81, 0xd4, 0x1b, 0xb0, 0x28, 0xc3, 0xb6, 0x11, 0x39, 0x68, 0xd9, 0x98, 0x50,
+	0x2f, 0x7a, 0x85, 0xac, 0x0b, 0x62, 0xbb, 0x8e, 0xc8, 0xc1, 0x96, 0xd8, 0x54, 0xff, 0x0d, 0x69,
+	0x86, 0x89, 0x8d, 0x83, 0xe8, 0x0e, 0x5a, 0xb1, 0xa4, 0xb7, 0xa1, 0x74, 0x2a, 0xd7, 0x11, 0xf3,
+	0x0d, 0x58, 0xda, 0xef, 0x11, 0x2e, 0xf6, 0x5a, 0x1e, 0xf2, 0x7d, 0x97, 0x38, 0xa3, 0xb7, 0x78,
+	0x02, 0xb0, 0xa1, 0x5f, 0x0c, 0xd9, 0xe2, 0xd0, 0x67, 0x27, 0x72, 0xd1, 0xbf, 0x53, 0xe0, 0xa2,
+	0x38, 0x84, 0x92, 0x10, 0x07, 0x7c, 0x93, 0xba, 0xa4, 0x49, 0x1b, 0xa1, 0xa7, 0x3e, 0x80, 0x3c,
+	0xa7, 0x2d, 0xcc, 0xbb, 0x2d, 0x64, 0xdb, 0xc1, 0x04, 0x26, 0x73, 0xaf, 0x83, 0x09, 0xa7, 0x0d,
+	0xde, 0x15, 0xcb, 0x89, 0x5a, 0x13, 0x93, 0xb5, 0xaa, 0xbb, 0x90, 0x93, 0x30, 0x89, 0x3f, 0x8f,
+	0x84, 0x21, 0x5f, 0x2b, 0x19, 0x71, 0xab, 0x88, 0x4f, 0x91, 0x11, 0x7f, 0x8a, 0x0c, 0x91, 0x62,
+	0xbd, 0x28, 0x12, 0x79, 0x39, 0xd0, 0x96, 0x8e, 0x90, 0x77, 0xb8, 0xae, 0x8f, 0x3c, 0x75, 0x2b,
+	0x2b, 0xd6, 0xc2, 0x46, 0xbf, 0x06, 0x57, 0x5e, 0x51, 0xd8, 0xa8, 0x13, 0x7e, 0x9c, 0x2a, 0xbc,
+	0x11, 0x7a, 0x4d, 0x2a, 0x8c, 0x26, 0x12, 0x54, 0xa6, 0x12, 0xdc, 0x03, 0x90, 0xed, 0x11, 0xe1,
+	0x91, 0xf8, 0x6b, 0x78, 0xc8, 0x48, 0x12, 0x8f, 0xdb, 0x90, 0x46, 0x72, 0x68, 0xc5, 0xf3, 0xf7,
+	0x5a, 0x1c, 0x72, 0xc6, 0x20, 0x8d, 0x8d, 0xd5, 0x2a, 0x14, 0x38, 0x8d, 0x1a, 0x4b, 0xe6, 0x13,
+	0x5d, 0x58, 0xe0, 0x54, 0x74, 0x95, 0x08, 0x3c, 0x5d, 0xfe, 0xa8, 0xbc, 0x61, 0xf9, 0xb5, 0x1f,
+	0x92, 0x90, 0xdc, 0x61, 0x8e, 0x4a, 0x00, 0x26, 0x3e, 0x75, 0xa5, 0xc9, 0xd6, 0x99, 0x9a, 0x38,
+	0xe5, 0xff, 0xcc, 0x54, 0x8d, 0x20, 0xd5, 0x1f, 0x7e, 0xfb, 0xcb, 0x67, 0x89, 0xab, 0x7a, 0x79,
+	0x58, 0xf8, 0xf0, 0x57, 0x1a, 0x9b, 0xb6, 0x78, 0x5f, 0xdd, 0x85, 0xc2, 0xd4, 0x94, 0xb8, 0x72,
+	0x22, 0xec, 0xa4, 0xb2, 0x7c, 0xfd, 0x0c, 0xe5, 0xe8, 0x22, 0x3c, 0x80, 0x85, 0x13, 0xd7, 0xf9,
+	0xda, 0x09, 0xb7, 0x69, 0x75, 0xf9, 0xbf, 0x67, 0xaa, 0x47, 0x71, 0xdf, 0x87, 0xa5, 0x53, 0xb7,
+	0x42, 0x3b, 0xe9, 0x7a, 0xc2, 0xa0, 0x7c, 0xf3, 0x1c, 0x83, 0x57, 0x44, 0x1f, 0xb7, 0xde, 0x8c,
+	0xe8, 0x23, 0x83, 0xf2, 0xcd, 0x73, 0x0c, 0x86, 0xd1, 0xeb, 0x6f, 0x3c, 0x39, 0xae, 0x28, 0x4f,
+	0x8f, 0x2b, 0xca, 0xcf, 0xc7, 0x15, 0xe5, 0x93, 0xe7, 0x95, 0xb9, 0xa7, 0xcf, 0x2b, 0x73, 0xdf,
+	0x3f, 0xaf, 0xcc, 0xbd, 0x77, 0xe3, 0xdc, 0x56, 0xed, 0x0b, 0xda, 0xda, 0x69, 0xf9, 0x91, 0xff,
+	0xff, 0xef, 0x03, 0x00, 0x64, 0xa8, 0xa5, 0x68, 0xd3, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -795,6 +899,10 @@ type MsgClient interface {
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
 	// representation.
 	ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping from
+	// the Ethereum address of the sender to the given recipient address
+	// ("to_bank_addr") in the corresponding Bank Coin representation.
+	ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error)
 }
 
 type msgClient struct {
@@ -841,6 +949,15 @@ func (c *msgClient) ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEv
 	return out, nil
 }
 
+func (c *msgClient) ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error) {
+	out := new(MsgConvertEvmToCoinResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertEvmToCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -856,6 +973,10 @@ type MsgServer interface {
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
 	// representation.
 	ConvertCoinToEvm(context.Context, *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping from
+	// the Ethereum address of the sender to the given recipient address
+	// ("to_bank_addr") in the corresponding Bank Coin representation.
+	ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -874,6 +995,9 @@ func (*UnimplementedMsgServer) CreateFunToken(ctx context.Context, req *MsgCreat
 func (*UnimplementedMsgServer) ConvertCoinToEvm(ctx context.Context, req *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToEvm not implemented")
 }
+func (*UnimplementedMsgServer) ConvertEvmToCoin(ctx context.Context, req *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToCoin not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -951,6 +1075,24 @@ func _Msg_ConvertCoinToEvm_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertEvmToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertEvmToCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertEvmToCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, req.(*MsgConvertEvmToCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eth.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -971,6 +1113,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertCoinToEvm",
 			Handler:    _Msg_ConvertCoinToEvm_Handler,
 		},
+		{
+			MethodName: "ConvertEvmToCoin",
+			Handler:    _Msg_ConvertEvmToCoin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/tx.proto",
@@ -1675,6 +1821,86 @@ func (m *MsgConvertCoinToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgConvertEvmToCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertEvmToCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToBankAddr) > 0 {
+		i -= len(m.ToBankAddr)
+		copy(dAtA[i:], m.ToBankAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToBankAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Erc20Addr.Size()
+		i -= size
+		if _, err := m.Erc20Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertEvmToCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertEvmToCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertEvmToCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1984,6 +2210,36 @@ func (m *MsgConvertCoinToEvmResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgConvertEvmToCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Erc20Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ToBankAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertEvmToCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4159,6 +4415,238 @@ func (m *MsgConvertCoinToEvmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgConvertEvmToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBankAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToBankAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertEvmToCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
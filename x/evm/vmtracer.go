@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -32,12 +33,7 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	case TracerAccessList:
 		rules := cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil)
 		precompileAddrs := vm.DefaultActivePrecompiles(rules)
-		return logger.NewAccessListTracer(
-			msg.AccessList(),
-			msg.From(),
-			*msg.To(),
-			precompileAddrs,
-		)
+		return NewAccessListTracer(msg, precompileAddrs)
 	case TracerJSON:
 		return logger.NewJSONLogger(logCfg, os.Stdout)
 	case TracerMarkdown:
@@ -49,6 +45,25 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	}
 }
 
+// NewAccessListTracer creates an access list tracer for the given message. The
+// sender, the recipient and the given precompiles are excluded from the access
+// list because they are always warm. For contract creation, the recipient is
+// the address of the created contract.
+func NewAccessListTracer(
+	msg core.Message, precompileAddrs []common.Address,
+) *logger.AccessListTracer {
+	to := crypto.CreateAddress(msg.From(), msg.Nonce())
+	if msg.To() != nil {
+		to = *msg.To()
+	}
+	return logger.NewAccessListTracer(
+		msg.AccessList(),
+		msg.From(),
+		to,
+		precompileAddrs,
+	)
+}
+
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
 	Result any    `json:"result,omitempty"` // Trace results produced by the tracer